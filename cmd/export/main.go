@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go_test/internal/domain"
+	"go_test/internal/infrastructure/mysql"
+	"go_test/internal/interface/archive"
+	"go_test/internal/interface/repository"
+	"go_test/internal/usecase"
+)
+
+// exportはNoteRepositoryのノートをアーカイブに書き出します
+// 例: go run ./cmd/export -format markdown-zip -from 2024-01-01 -tag work -out notes.zip
+func main() {
+	var tags tagFlags
+	formatName := flag.String("format", string(archive.FormatMarkdownZip), "archive format: markdown-zip, markdown-tar, ndjson or csv")
+	out := flag.String("out", "", "output file (default notes<ext> in the current directory, - for stdout)")
+	from := flag.String("from", "", "only notes updated at or after this time (RFC3339 or YYYY-MM-DD)")
+	to := flag.String("to", "", "only notes updated before this time (RFC3339 or YYYY-MM-DD)")
+	flag.Var(&tags, "tag", "only notes carrying this tag (repeatable or comma-separated; all must match)")
+	flag.Parse()
+
+	format, err := archive.ParseFormat(*formatName)
+	if err != nil {
+		log.Fatalf("Invalid -format: %v", err)
+	}
+	filter := usecase.ExportFilter{Tags: tags}
+	if filter.UpdatedFrom, err = parseTime(*from); err != nil {
+		log.Fatalf("Invalid -from: %v", err)
+	}
+	if filter.UpdatedTo, err = parseTime(*to); err != nil {
+		log.Fatalf("Invalid -to: %v", err)
+	}
+
+	// MySQL接続を初期化
+	mysqlConfig := mysql.NewConfig()
+	db, err := mysql.Connect(mysqlConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to MySQL: %v", err)
+	}
+	defer db.Close()
+
+	path := *out
+	if path == "" {
+		path = "notes" + format.Extension()
+	}
+	var dst io.Writer = os.Stdout
+	var file *os.File
+	if path != "-" {
+		file, err = os.Create(path)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", path, err)
+		}
+		dst = file
+	}
+	buffered := bufio.NewWriter(dst)
+
+	count, err := export(context.Background(), usecase.NewExportInteractor(repository.NewMySQLRepository(db)), filter, format, buffered)
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if file != nil {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			// 途中までのアーカイブは残しません
+			os.Remove(path)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Failed to export notes: %v", err)
+	}
+
+	log.Printf("Exported %d notes to %s", count, path)
+}
+
+// export はfilterに一致するノートをformatのアーカイブとしてwに書き込みます
+func export(ctx context.Context, exportUsecase usecase.ExportUsecase, filter usecase.ExportFilter, format archive.Format, w io.Writer) (int, error) {
+	aw, err := archive.NewWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+	count, err := exportUsecase.ExportNotes(ctx, filter, func(note *domain.Note) error {
+		return aw.WriteNote(note)
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, aw.Close()
+}
+
+// parseTime はRFC3339またはYYYY-MM-DD（UTCの0時）の日時を解析します。空の場合はゼロ値を返します
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+// tagFlags は繰り返し指定やカンマ区切りで指定された-tagを集めます
+type tagFlags []string
+
+func (t *tagFlags) String() string {
+	return strings.Join(*t, ",")
+}
+
+func (t *tagFlags) Set(value string) error {
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			*t = append(*t, tag)
+		}
+	}
+	return nil
+}
+
+// init は使い方の先頭にコマンドの説明を加えます
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nExports notes outside the trash (archived notes included).\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go_test/internal/interface/archive"
+	"go_test/pkg/client"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+)
+
+// chunkSize はファイルの内容を送信するメッセージ1つあたりの最大バイト数です
+const chunkSize = 64 << 10
+
+// importはMarkdownのフォルダ、ENEX、NDJSON、CSVのファイルをImportNotes RPCでインポートします
+// サーバーを経由するため、ノートの検証、キャッシュ、検索インデックスはサーバーでの作成と同じように扱われます
+// 例: go run ./cmd/import -tag imported ~/vault notes.enex
+func main() {
+	var tags tagFlags
+	addr := flag.String("addr", getEnv("GRPC_ADDR", "localhost:50051"), "gRPC server address")
+	token := flag.String("token", os.Getenv("AUTH_TOKEN"), "bearer token (default $AUTH_TOKEN)")
+	insecure := flag.Bool("insecure", false, "connect without TLS; the bearer token is sent in plaintext (local development only)")
+	notebookID := flag.Int64("notebook", 0, "notebook ID to file every imported note in")
+	flag.Var(&tags, "tag", "tag added to every imported note (repeatable or comma-separated)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <file or directory>...\n\nImports .md, .markdown, .enex, .ndjson, .jsonl and .csv files. Directories are searched recursively; hidden files and directories are skipped.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := collectFiles(flag.Args())
+	if err != nil {
+		log.Fatalf("Failed to collect files: %v", err)
+	}
+	if len(files) == 0 {
+		log.Fatalf("No files to import")
+	}
+
+	config := client.NewConfig(*addr)
+	config.Token = *token
+	// 既定ではTLSで接続し、ベアラートークンが平文で送られないようにします
+	config.Insecure = *insecure
+	c, err := client.Dial(config)
+	if err != nil {
+		log.Fatalf("Failed to connect to %s: %v", *addr, err)
+	}
+	defer c.Close()
+
+	resp, err := importFiles(context.Background(), c, &v1.ImportOptions{NotebookId: *notebookID, Tags: tags}, files)
+	if err != nil {
+		log.Fatalf("Failed to import notes: %v", err)
+	}
+
+	for _, file := range resp.Files {
+		if file.ErrorCode != 0 {
+			fmt.Printf("%s: failed: %s\n", file.Filename, file.ErrorMessage)
+			continue
+		}
+		var created, duplicates, failed int
+		for _, note := range file.Notes {
+			switch note.Status {
+			case v1.ImportNoteStatus_IMPORT_NOTE_STATUS_CREATED:
+				created++
+			case v1.ImportNoteStatus_IMPORT_NOTE_STATUS_DUPLICATE:
+				duplicates++
+			default:
+				failed++
+			}
+		}
+		fmt.Printf("%s: %d created, %d duplicates, %d failed\n", file.Filename, created, duplicates, failed)
+		for _, note := range file.Notes {
+			if note.Status == v1.ImportNoteStatus_IMPORT_NOTE_STATUS_FAILED {
+				fmt.Printf("  [%d] %q: %s\n", note.Index, note.Title, note.ErrorMessage)
+			}
+		}
+	}
+	fmt.Printf("Imported %d notes (%d duplicates, %d failed notes, %d failed files)\n", resp.CreatedCount, resp.DuplicateCount, resp.FailedCount, resp.FailedFileCount)
+
+	if resp.FailedCount > 0 || resp.FailedFileCount > 0 {
+		os.Exit(1)
+	}
+}
+
+// importFile はインポートするファイルのパスと、サーバーに送るファイル名です
+type importFile struct {
+	path string
+	name string
+}
+
+// collectFiles は引数のファイルと、ディレクトリ以下のインポートできるファイルを集めます
+// ディレクトリ以下のファイル名は、そのディレクトリの名前からの相対パスで送ります
+func collectFiles(args []string) ([]importFile, error) {
+	var files []importFile
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, importFile{path: arg, name: filepath.Base(arg)})
+			continue
+		}
+
+		root := filepath.Clean(arg)
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() || !archive.Supported(path) {
+				return nil
+			}
+			rel, err := filepath.Rel(filepath.Dir(root), path)
+			if err != nil {
+				return err
+			}
+			files = append(files, importFile{path: path, name: filepath.ToSlash(rel)})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// importFiles はファイルを順にImportNotesのストリームで送信し、結果を受け取ります
+func importFiles(ctx context.Context, c *client.Client, options *v1.ImportOptions, files []importFile) (*v1.ImportNotesResponse, error) {
+	stream, err := c.ImportNotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&v1.ImportNotesRequest{Payload: &v1.ImportNotesRequest_Options{Options: options}}); err != nil {
+		return nil, closeAndRecvError(stream, err)
+	}
+
+	buf := make([]byte, chunkSize)
+	for _, file := range files {
+		if err := sendFile(stream, file, buf); err != nil {
+			return nil, closeAndRecvError(stream, err)
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// sendFile は1ファイル分のメッセージを送信します
+func sendFile(stream grpc.ClientStreamingClient[v1.ImportNotesRequest, v1.ImportNotesResponse], file importFile, buf []byte) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := stream.Send(&v1.ImportNotesRequest{Payload: &v1.ImportNotesRequest_File{File: &v1.ImportFile{Filename: file.name}}}); err != nil {
+		return err
+	}
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&v1.ImportNotesRequest{Payload: &v1.ImportNotesRequest_Chunk{Chunk: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.path, err)
+		}
+	}
+}
+
+// closeAndRecvError は送信に失敗した理由を返します
+// サーバーがストリームを終了した場合、Sendはio.EOFを返し、理由はCloseAndRecvで受け取ります
+func closeAndRecvError(stream grpc.ClientStreamingClient[v1.ImportNotesRequest, v1.ImportNotesResponse], err error) error {
+	if err == io.EOF {
+		_, err = stream.CloseAndRecv()
+	}
+	return err
+}
+
+// getEnv はデフォルト値付きで環境変数を取得します
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// tagFlags は繰り返し指定やカンマ区切りで指定された-tagを集めます
+type tagFlags []string
+
+func (t *tagFlags) String() string {
+	return strings.Join(*t, ",")
+}
+
+func (t *tagFlags) Set(value string) error {
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			*t = append(*t, tag)
+		}
+	}
+	return nil
+}
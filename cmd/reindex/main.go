@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"go_test/internal/infrastructure/mysql"
+	"go_test/internal/interface/repository"
+	"go_test/internal/interface/search"
+	"go_test/internal/usecase"
+)
+
+// reindexはNoteRepositoryのノートから組み込み検索インデックスを再構築し、
+// SEARCH_INDEX_PATHにスナップショットを書き込みます
+// 実行中のサーバーは終了時に自身のインデックスを書き込むため、サーバー停止中に実行してください
+func main() {
+	// MySQL接続を初期化
+	mysqlConfig := mysql.NewConfig()
+	db, err := mysql.Connect(mysqlConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to MySQL: %v", err)
+	}
+	defer db.Close()
+
+	// 既存のスナップショットは読み込まずに新しいインデックスを作成
+	path := getEnv("SEARCH_INDEX_PATH", "data/search_index.gob")
+	index, err := search.NewInvertedIndex("")
+	if err != nil {
+		log.Fatalf("Failed to create search index: %v", err)
+	}
+
+	noteRepo := repository.NewMySQLRepository(db)
+	count, err := usecase.NewSearchIndexInteractor(noteRepo, index).Reindex(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to reindex notes: %v", err)
+	}
+
+	if err := index.SaveTo(path); err != nil {
+		log.Fatalf("Failed to save search index: %v", err)
+	}
+
+	log.Printf("Indexed %d notes into %s", count, path)
+}
+
+// getEnv はデフォルト値付きで環境変数を取得します
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
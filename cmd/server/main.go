@@ -6,13 +6,21 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"go_test/internal/infrastructure/mysql"
 	redisInfra "go_test/internal/infrastructure/redis"
+	"go_test/internal/interface/auth"
+	"go_test/internal/interface/blob"
 	"go_test/internal/interface/cache"
 	"go_test/internal/interface/grpc"
+	"go_test/internal/interface/markdown"
 	"go_test/internal/interface/repository"
+	"go_test/internal/interface/search"
+	"go_test/internal/interface/webhook"
+	"go_test/internal/interface/worker"
 	"go_test/internal/usecase"
 
 	"database/sql"
@@ -45,14 +53,77 @@ func main() {
 
 	// リポジトリとキャッシュを初期化
 	noteRepo := repository.NewMySQLRepository(db)
+	revisionRepo := repository.NewMySQLRevisionRepository(db)
+	tagRepo := repository.NewMySQLTagRepository(db)
+	notebookRepo := repository.NewMySQLNotebookRepository(db)
+	linkRepo := repository.NewMySQLLinkRepository(db)
+	templateRepo := repository.NewMySQLTemplateRepository(db)
+	attachmentRepo := repository.NewMySQLAttachmentRepository(db)
+	commentRepo := repository.NewMySQLCommentRepository(db)
+	outboxRepo := repository.NewMySQLOutboxRepository(db)
+	webhookRepo := repository.NewMySQLWebhookRepository(db)
+	webhookDeliveryRepo := repository.NewMySQLWebhookDeliveryRepository(db)
 	redisCache := cache.NewRedisCache(redisClient)
 
+	idempotencyStore := cache.NewRedisIdempotencyStore(redisClient)
+	noteEvents := cache.NewRedisNoteEventBus(redisClient, getInt64Env("WATCH_EVENT_RETENTION", 10000))
+
+	// 添付ファイルの保存先を初期化
+	blobStore, err := blob.NewLocalStore(getEnv("ATTACHMENT_DIR", "data/attachments"))
+	if err != nil {
+		log.Fatalf("Failed to open attachment store: %v", err)
+	}
+
+	// 認証トークンを読み込み（AUTH_TOKENS="トークン=主体ID,..."）
+	authTokens, err := auth.ParseTokens(os.Getenv("AUTH_TOKENS"))
+	if err != nil {
+		log.Fatalf("Failed to parse AUTH_TOKENS: %v", err)
+	}
+	authenticator := auth.NewStaticAuthenticator(authTokens)
+
+	// 検索エンジンを初期化（SEARCH_ENGINE=embeddedの場合は組み込みの転置インデックスを使用）
+	var searchIndex usecase.SearchIndex
+	var embeddedIndex *search.InvertedIndex
+	if getEnv("SEARCH_ENGINE", "mysql") == "embedded" {
+		embeddedIndex, err = search.NewInvertedIndex(getEnv("SEARCH_INDEX_PATH", "data/search_index.gob"))
+		if err != nil {
+			log.Fatalf("Failed to open search index: %v", err)
+		}
+		searchIndex = embeddedIndex
+	}
+
 	// ユースケースを初期化
-	noteUsecase := usecase.NewNoteInteractor(noteRepo, redisCache)
+	idempotencyTTL := getDurationEnv("IDEMPOTENCY_TTL", 24*time.Hour)
+	noteUsecase := usecase.NewNoteInteractor(noteRepo, notebookRepo, redisCache, idempotencyStore, idempotencyTTL, searchIndex)
+	revisionUsecase := usecase.NewNoteRevisionInteractor(noteRepo, revisionRepo, redisCache, searchIndex)
+	trashUsecase := usecase.NewTrashInteractor(noteRepo, redisCache, searchIndex)
+	tagUsecase := usecase.NewTagInteractor(tagRepo)
+	notebookUsecase := usecase.NewNotebookInteractor(notebookRepo, noteRepo, redisCache, searchIndex)
+	renderUsecase := usecase.NewRenderInteractor(noteRepo, redisCache, markdown.NewRenderer())
+	linkUsecase := usecase.NewLinkInteractor(noteRepo, linkRepo)
+	templateUsecase := usecase.NewTemplateInteractor(templateRepo, noteUsecase)
+	attachmentUsecase := usecase.NewAttachmentInteractor(noteRepo, attachmentRepo, blobStore, getInt64Env("ATTACHMENT_MAX_BYTES", 10<<20))
+	commentUsecase := usecase.NewCommentInteractor(noteRepo, commentRepo)
+	watchUsecase := usecase.NewWatchInteractor(noteEvents)
+	relayUsecase := usecase.NewNoteEventRelayInteractor(outboxRepo, noteEvents, int(getInt64Env("OUTBOX_MAX_ATTEMPTS", 10)))
+	webhookUsecase := usecase.NewWebhookInteractor(webhookRepo, webhookDeliveryRepo)
+	webhookSender := webhook.NewHTTPSender(getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second), os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS") == "true")
+	webhookDispatchUsecase := usecase.NewWebhookDispatchInteractor(webhookRepo, webhookDeliveryRepo, webhookSender, int(getInt64Env("WEBHOOK_MAX_ATTEMPTS", 8)))
+	exportUsecase := usecase.NewExportInteractor(noteRepo)
+	importUsecase := usecase.NewImportInteractor(noteRepo, noteUsecase)
 	pingUsecase := usecase.NewPingInteractor(&sqlPinger{db: db}, &redisPinger{client: redisClient})
 
+	// 組み込みインデックスが空の場合はNoteRepositoryから構築
+	if embeddedIndex != nil && embeddedIndex.Len() == 0 {
+		count, err := usecase.NewSearchIndexInteractor(noteRepo, searchIndex).Reindex(ctx)
+		if err != nil {
+			log.Fatalf("Failed to build search index: %v", err)
+		}
+		log.Printf("Built search index with %d notes", count)
+	}
+
 	// gRPCサーバーを初期化
-	grpcServer := grpc.NewServer(noteUsecase, pingUsecase)
+	grpcServer := grpc.NewServer(noteUsecase, revisionUsecase, trashUsecase, tagUsecase, notebookUsecase, renderUsecase, linkUsecase, templateUsecase, attachmentUsecase, commentUsecase, watchUsecase, webhookUsecase, exportUsecase, importUsecase, pingUsecase, authenticator)
 
 	// gRPCサーバーを開始
 	port := getEnv("GRPC_PORT", "50051")
@@ -70,15 +141,53 @@ func main() {
 		}
 	}()
 
+	// バックグラウンドワーカーを開始
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+
+	trashRetention := getDurationEnv("TRASH_RETENTION", 30*24*time.Hour)
+	trashPurgeInterval := getDurationEnv("TRASH_PURGE_INTERVAL", time.Hour)
+	go noteEvents.Run(workerCtx)
+	go worker.NewOutboxRelay(relayUsecase, getDurationEnv("OUTBOX_RELAY_INTERVAL", 200*time.Millisecond)).Run(workerCtx)
+	go worker.NewWebhookDispatcher(noteEvents, webhookDispatchUsecase, webhookConsumerName()).Run(workerCtx)
+	go worker.NewWebhookDeliverer(webhookDispatchUsecase, getDurationEnv("WEBHOOK_DELIVERY_INTERVAL", time.Second)).Run(workerCtx)
+
+	go worker.NewTrashPurger(trashUsecase, trashRetention, trashPurgeInterval).Run(workerCtx)
+
+	attachmentSweepGrace := getDurationEnv("ATTACHMENT_SWEEP_GRACE", time.Hour)
+	attachmentSweepInterval := getDurationEnv("ATTACHMENT_SWEEP_INTERVAL", time.Hour)
+	go worker.NewAttachmentSweeper(attachmentUsecase, attachmentSweepGrace, attachmentSweepInterval).Run(workerCtx)
+
+	if embeddedIndex != nil {
+		go embeddedIndex.RunFlusher(workerCtx, getDurationEnv("SEARCH_INDEX_FLUSH_INTERVAL", time.Minute))
+	}
+
 	// 割り込みシグナルを待ってサーバーを正常にシャットダウン
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopWorkers()
+
+	// 正常なシャットダウン（WatchNotesのような終わらないストリームがあるため、時間内に終わらない場合は強制的に停止）
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(getDurationEnv("GRPC_SHUTDOWN_TIMEOUT", 10*time.Second)):
+		log.Println("Graceful shutdown timed out, closing remaining connections")
+		grpcServer.Stop()
+	}
 
-	// 正常なシャットダウン
-	grpcServer.GracefulStop()
+	if embeddedIndex != nil {
+		if err := embeddedIndex.Save(); err != nil {
+			log.Printf("Warning: failed to save search index: %v", err)
+		}
+	}
 }
 
 // loadEnv は.envファイルが存在する場合に環境変数を読み込みます
@@ -98,6 +207,53 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getDurationEnv はデフォルト値付きで期間を表す環境変数を取得します
+// 間隔や保持期間に使うため、0以下の値はデフォルト値に置き換えます（time.NewTickerは0以下の間隔でパニックします）
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid duration for %s: %v, using default %s", key, err, defaultValue)
+		return defaultValue
+	}
+	if d <= 0 {
+		log.Printf("Warning: duration for %s must be positive: %q, using default %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getInt64Env はデフォルト値付きで整数を表す環境変数を取得します
+func getInt64Env(key string, defaultValue int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		log.Printf("Warning: invalid value for %s: %q, using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
+
+// webhookConsumerName はWebhookのコンシューマーグループでこのレプリカを識別する名前を返します
+// WEBHOOK_CONSUMER_NAMEが指定されていない場合はホスト名を使用します
+func webhookConsumerName() string {
+	if name := os.Getenv("WEBHOOK_CONSUMER_NAME"); name != "" {
+		return name
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Printf("Warning: failed to get hostname for webhook consumer name: %v", err)
+		return "server"
+	}
+	return hostname
+}
+
 // sqlPinger はusecase.SQLPingerインターフェースを実装します
 type sqlPinger struct {
 	db *sql.DB
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxAttachmentFilenameLength は添付ファイル名の最大文字数（rune数）です
+const MaxAttachmentFilenameLength = 255
+
+// Attachment はノートに添付されたファイルのメタデータを表します
+// 内容はSHA256をキーとしてBlobStoreに保存され、同じ内容のファイルは共有されます
+type Attachment struct {
+	ID          int64     `json:"id"`
+	NoteID      int64     `json:"note_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ValidateAttachmentFilename は添付ファイル名を検証します
+// パスを含む名前は保存先を推測させないよう拒否します
+func ValidateAttachmentFilename(filename string) error {
+	verr := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(filename) == "":
+		verr.add("filename", "is required")
+	case utf8.RuneCountInString(filename) > MaxAttachmentFilenameLength:
+		verr.add("filename", fmt.Sprintf("must be at most %d characters", MaxAttachmentFilenameLength))
+	case strings.ContainsAny(filename, `/\`), filename == ".", filename == "..":
+		verr.add("filename", "must not contain a path")
+	default:
+		if msg := validateText(filename, false); msg != "" {
+			verr.add("filename", msg)
+		}
+	}
+
+	return verr.errOrNil()
+}
+
+// SniffContentTypeLength はSniffContentTypeが参照する先頭のバイト数です
+const SniffContentTypeLength = 512
+
+// SniffContentType はファイルの先頭のバイト列からMIMEタイプを判定します
+// クライアントが申告した種類は信頼せず、内容のみから判定します
+func SniffContentType(head []byte) string {
+	return http.DetectContentType(head)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCommentBodyLength はコメント本文の最大文字数（rune数）です
+const MaxCommentBodyLength = 10000
+
+// Comment はノートに付けられたコメントを表します
+// ParentIDで他のコメントへの返信としてスレッドを構成します
+type Comment struct {
+	ID     int64 `json:"id"`
+	NoteID int64 `json:"note_id"`
+	// ParentID は返信先のコメントのIDです。ノートへの直接のコメントの場合は0です
+	ParentID int64 `json:"parent_id"`
+	// Author はコメントを作成した主体のIDです
+	Author string `json:"author"`
+	Body   string `json:"body"`
+	// ReplyCount は直接の返信の数です（読み取り時のみ設定されます）
+	ReplyCount int64     `json:"reply_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	// DeletedAt は削除された日時です。返信のスレッドを保つため、削除後も本文を空にして残します
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// NewComment はドメインルールを検証して新しいCommentインスタンスを作成します
+func NewComment(noteID, parentID int64, author, body string) (*Comment, error) {
+	if err := ValidateComment(body); err != nil {
+		return nil, err
+	}
+	return &Comment{
+		NoteID:   noteID,
+		ParentID: parentID,
+		Author:   author,
+		Body:     body,
+	}, nil
+}
+
+// Edit はドメインルールを検証して本文を変更します
+func (c *Comment) Edit(body string) error {
+	if err := ValidateComment(body); err != nil {
+		return err
+	}
+	c.Body = body
+	return nil
+}
+
+// IsDeleted はコメントが削除されているかどうかを返します
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// IsAuthoredBy はコメントがpの作成したものかどうかを返します
+func (c *Comment) IsAuthoredBy(p *Principal) bool {
+	return p != nil && c.Author == p.ID
+}
+
+// ValidateComment はコメント本文を検証します
+func ValidateComment(body string) error {
+	verr := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(body) == "":
+		verr.add("body", "is required")
+	case utf8.RuneCountInString(body) > MaxCommentBodyLength:
+		verr.add("body", fmt.Sprintf("must be at most %d characters", MaxCommentBodyLength))
+	default:
+		if msg := validateText(body, true); msg != "" {
+			verr.add("body", msg)
+		}
+	}
+
+	return verr.errOrNil()
+}
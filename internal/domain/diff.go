@@ -0,0 +1,392 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// DiffGranularity は差分を計算する単位を表します
+type DiffGranularity int
+
+const (
+	// DiffByLine は行単位で差分を計算します
+	DiffByLine DiffGranularity = iota
+	// DiffByWord は単語単位で差分を計算します（日本語は1文字ずつ比較します）
+	DiffByWord
+)
+
+// DiffOp は差分の操作種別を表します
+type DiffOp int
+
+const (
+	DiffEqual DiffOp = iota
+	DiffDelete
+	DiffInsert
+)
+
+// maxDiffEditDistance は差分計算で探索する編集距離の上限です
+// これを超える場合は残りの範囲を全削除・全挿入として扱い、計算量とメモリ使用量を抑えます
+const maxDiffEditDistance = 1000
+
+// DiffEdit は連続する同じ操作のテキストを表します
+type DiffEdit struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffHunk は変更箇所とその前後の文脈をまとめた単位です
+// 開始位置は1始まりで、単位はGranularityに従います（行または単語）
+type DiffHunk struct {
+	OldStart int
+	OldCount int
+	NewStart int
+	NewCount int
+	Edits    []DiffEdit
+}
+
+// NoteDiff はノートの2つのバージョン間の差分を表します
+// ToVersionが0の場合は現在の内容との比較です
+type NoteDiff struct {
+	NoteID      int64
+	FromVersion int64
+	ToVersion   int64
+	OldTitle    string
+	NewTitle    string
+	Granularity DiffGranularity
+	Hunks       []DiffHunk
+	Unified     string
+}
+
+// diffToken はトークン単位の操作です
+type diffToken struct {
+	op   DiffOp
+	text string
+}
+
+// ComputeDiff は2つのテキストの差分をcontextSize単位の文脈付きのハンクとして返します
+func ComputeDiff(oldText, newText string, granularity DiffGranularity, contextSize int) []DiffHunk {
+	tokenize := splitLines
+	if granularity == DiffByWord {
+		tokenize = splitWords
+	}
+	tokens := diffTokens(tokenize(oldText), tokenize(newText))
+	return buildHunks(tokens, contextSize)
+}
+
+// FormatUnifiedDiff はハンクをunified diff形式のテキストに変換します
+// 単語単位の場合、各ハンクの本文は[-削除-]{+追加+}で変更箇所を示します
+func FormatUnifiedDiff(oldLabel, newLabel string, hunks []DiffHunk, granularity DiffGranularity) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldLabel, newLabel)
+	for _, h := range hunks {
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(h.OldStart, h.OldCount), hunkRange(h.NewStart, h.NewCount))
+		if granularity == DiffByWord {
+			writeWordHunk(&b, h)
+		} else {
+			writeLineHunk(&b, h)
+		}
+	}
+	return b.String()
+}
+
+// hunkRange はunified diffのハンクヘッダーの範囲表記を返します
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// 空の範囲は直前の位置で表します
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// writeLineHunk は行単位のハンク本文を書き込みます
+func writeLineHunk(b *strings.Builder, h DiffHunk) {
+	for _, e := range h.Edits {
+		prefix := " "
+		switch e.Op {
+		case DiffDelete:
+			prefix = "-"
+		case DiffInsert:
+			prefix = "+"
+		}
+		for _, line := range splitLines(e.Text) {
+			b.WriteString(prefix)
+			b.WriteString(line)
+			if !strings.HasSuffix(line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+}
+
+// writeWordHunk は単語単位のハンク本文を書き込みます
+func writeWordHunk(b *strings.Builder, h DiffHunk) {
+	var body strings.Builder
+	for _, e := range h.Edits {
+		switch e.Op {
+		case DiffDelete:
+			body.WriteString("[-" + e.Text + "-]")
+		case DiffInsert:
+			body.WriteString("{+" + e.Text + "+}")
+		default:
+			body.WriteString(e.Text)
+		}
+	}
+	text := body.String()
+	b.WriteString(text)
+	if !strings.HasSuffix(text, "\n") {
+		b.WriteString("\n")
+	}
+}
+
+// splitLines は改行を含めて行に分割します
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitWords は単語・空白・記号に分割します。漢字・ひらがな・カタカナは1文字ずつ分割します
+func splitWords(s string) []string {
+	var tokens []string
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		j := i + 1
+		switch {
+		case isCJK(r):
+		case unicode.IsSpace(r):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		case isWordRune(r):
+			for j < len(runes) && isWordRune(runes[j]) && !isCJK(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// diffTokens は共通の先頭・末尾を除いた範囲にMyersのアルゴリズムを適用してトークン単位の操作列を返します
+func diffTokens(a, b []string) []diffToken {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	tokens := make([]diffToken, 0, len(a)+len(b))
+	for _, t := range a[:prefix] {
+		tokens = append(tokens, diffToken{op: DiffEqual, text: t})
+	}
+	tokens = append(tokens, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, t := range a[len(a)-suffix:] {
+		tokens = append(tokens, diffToken{op: DiffEqual, text: t})
+	}
+	return tokens
+}
+
+// myers はMyersの差分アルゴリズムで最短編集スクリプトを計算します
+func myers(a, b []string) []diffToken {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return replaceAll(a, b)
+	}
+
+	limit := n + m
+	if limit > maxDiffEditDistance {
+		limit = maxDiffEditDistance
+	}
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	// trace[d]はステップd終了時点の対角線k（-d..d）ごとの到達位置xです
+	var trace [][]int
+
+	found := false
+	for d := 0; d <= limit && !found; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+			}
+		}
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+	}
+	if !found {
+		return replaceAll(a, b)
+	}
+
+	// 終点から逆向きに編集操作をたどります
+	var reversed []diffToken
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffToken{op: DiffEqual, text: a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, diffToken{op: DiffInsert, text: b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, diffToken{op: DiffDelete, text: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, diffToken{op: DiffEqual, text: a[x-1]})
+		x--
+		y--
+	}
+
+	tokens := make([]diffToken, len(reversed))
+	for i, t := range reversed {
+		tokens[len(reversed)-1-i] = t
+	}
+	return tokens
+}
+
+// replaceAll はaをすべて削除してbをすべて挿入する操作列を返します
+func replaceAll(a, b []string) []diffToken {
+	tokens := make([]diffToken, 0, len(a)+len(b))
+	for _, t := range a {
+		tokens = append(tokens, diffToken{op: DiffDelete, text: t})
+	}
+	for _, t := range b {
+		tokens = append(tokens, diffToken{op: DiffInsert, text: t})
+	}
+	return tokens
+}
+
+// buildHunks は変更箇所の前後contextSize個の一致トークンを含めてハンクにまとめます
+// 文脈が重なる変更箇所は1つのハンクに結合します
+func buildHunks(tokens []diffToken, contextSize int) []DiffHunk {
+	if contextSize < 0 {
+		contextSize = 0
+	}
+
+	var hunks []DiffHunk
+	i := 0
+	for i < len(tokens) {
+		// 次の変更箇所を探します
+		start := i
+		for start < len(tokens) && tokens[start].op == DiffEqual {
+			start++
+		}
+		if start == len(tokens) {
+			break
+		}
+
+		// 一致トークンがcontextSize*2以下で続く限り同じハンクに含めます
+		end := start
+		for end < len(tokens) {
+			if tokens[end].op != DiffEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(tokens) && tokens[run].op == DiffEqual {
+				run++
+			}
+			if run == len(tokens) || run-end > contextSize*2 {
+				break
+			}
+			end = run
+		}
+
+		from := start - contextSize
+		if from < i {
+			from = i
+		}
+		if from < 0 {
+			from = 0
+		}
+		to := end + contextSize
+		if to > len(tokens) {
+			to = len(tokens)
+		}
+
+		hunks = append(hunks, newHunk(tokens, from, to))
+		i = to
+	}
+	return hunks
+}
+
+// newHunk はtokens[from:to]からハンクを作成します
+func newHunk(tokens []diffToken, from, to int) DiffHunk {
+	oldPos, newPos := 1, 1
+	for _, t := range tokens[:from] {
+		if t.op != DiffInsert {
+			oldPos++
+		}
+		if t.op != DiffDelete {
+			newPos++
+		}
+	}
+
+	h := DiffHunk{OldStart: oldPos, NewStart: newPos}
+	for _, t := range tokens[from:to] {
+		if t.op != DiffInsert {
+			h.OldCount++
+		}
+		if t.op != DiffDelete {
+			h.NewCount++
+		}
+		if n := len(h.Edits); n > 0 && h.Edits[n-1].Op == t.op {
+			h.Edits[n-1].Text += t.text
+			continue
+		}
+		h.Edits = append(h.Edits, DiffEdit{Op: t.op, Text: t.text})
+	}
+	return h
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrNoteNotFound は指定されたノートが存在しない場合に返されます
+	ErrNoteNotFound = errors.New("note not found")
+	// ErrVersionConflict はノートが期待したバージョンから更新されていた場合に返されます
+	ErrVersionConflict = errors.New("note version conflict")
+	// ErrNoteNotTrashed はゴミ箱にないノートを復元・完全削除しようとした場合に返されます
+	ErrNoteNotTrashed = errors.New("note is not in the trash")
+	// ErrRevisionNotFound は指定されたリビジョンが存在しない場合に返されます
+	ErrRevisionNotFound = errors.New("note revision not found")
+	// ErrNotebookNotFound は指定されたノートブックが存在しない場合に返されます
+	ErrNotebookNotFound = errors.New("notebook not found")
+	// ErrNotebookCycle はノートブックを自身またはその子孫の下に移動しようとした場合に返されます
+	ErrNotebookCycle = errors.New("notebook cannot be moved under itself or its descendants")
+	// ErrNotebookNotEmpty は子のノートブックまたはノートを含むノートブックを削除しようとした場合に返されます
+	ErrNotebookNotEmpty = errors.New("notebook is not empty")
+	// ErrNoteArchived はアーカイブされたノートを固定しようとした場合に返されます
+	ErrNoteArchived = errors.New("note is archived")
+	// ErrTemplateNotFound は指定されたテンプレートが存在しない場合に返されます
+	ErrTemplateNotFound = errors.New("note template not found")
+	// ErrAttachmentNotFound は指定された添付ファイルが存在しない場合に返されます
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrAttachmentTooLarge は添付ファイルが上限サイズを超えた場合に返されます
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+	// ErrCommentNotFound は指定されたコメントが存在しないか削除されている場合に返されます
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrUnauthenticated は認証が必要な操作を認証されていない主体が行おうとした場合や、認証情報が無効な場合に返されます
+	ErrUnauthenticated = errors.New("authentication required")
+	// ErrPermissionDenied は主体に操作の権限がない場合に返されます
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrWebhookNotFound は指定されたWebhookが存在しない場合に返されます
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrWatchLagging はイベントの購読者が受信に追いつけず購読を打ち切った場合に返されます
+	// 最後に受信したイベントIDから購読し直すことで欠落なく再開できます
+	ErrWatchLagging = errors.New("watcher fell behind the event stream")
+	// ErrWatchCursorExpired は再開しようとしたイベントより後のイベントが保持期間を過ぎて削除されている場合に返されます
+	// 欠落なく再開できないため、購読側は状態を取得し直してから新しいイベントを購読します
+	ErrWatchCursorExpired = errors.New("events after the given event ID are no longer retained")
+)
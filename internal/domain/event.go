@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// NoteEventType はノートの変更イベントの種類を表します
+type NoteEventType string
+
+const (
+	// NoteCreated はノートが作成されたことを表します
+	NoteCreated NoteEventType = "created"
+	// NoteUpdated はノートの内容・状態・ノートブックが変更されたことを表します
+	NoteUpdated NoteEventType = "updated"
+	// NoteDeleted はノートがゴミ箱に移動されたことを表します
+	NoteDeleted NoteEventType = "deleted"
+	// NoteRestored はノートがゴミ箱から元に戻されたことを表します
+	NoteRestored NoteEventType = "restored"
+	// NotePurged はゴミ箱にあるノートが完全に削除されたことを表します。Versionは削除時点のバージョンです
+	NotePurged NoteEventType = "purged"
+)
+
+// NoteEvent はノートの変更イベントを表します
+type NoteEvent struct {
+	// ID はイベントストリーム内でイベントを一意に識別し、発生順に並ぶIDです。発行時に設定されます
+	ID      string        `json:"id"`
+	Type    NoteEventType `json:"type"`
+	NoteID  int64         `json:"note_id"`
+	Version int64         `json:"version"`
+	// OccurredAt はイベントが発生した日時です
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// WikiLink は本文中の[[...]]形式のリンクを表します
+// [[123]]のように数字のみの場合はNoteIDで、それ以外はTitleでノートを参照します
+type WikiLink struct {
+	NoteID int64
+	Title  string
+}
+
+// Target はリンクの参照先をリンク記法の中身として返します
+func (l WikiLink) Target() string {
+	if l.NoteID > 0 {
+		return strconv.FormatInt(l.NoteID, 10)
+	}
+	return l.Title
+}
+
+// NoteLink は参照先のノートを解決したリンクを表します
+type NoteLink struct {
+	Link WikiLink
+	// TargetNoteID は参照先のノートのIDです。参照先が存在しない場合は0です
+	TargetNoteID int64
+	// TargetTitle は参照先のノートの現在のタイトルです。参照先が存在しない場合は空です
+	TargetTitle string
+}
+
+// Links は本文に含まれるリンクを返します
+func (n *Note) Links() []WikiLink {
+	return ParseWikiLinks(n.Content)
+}
+
+// ParseWikiLinks は本文から[[note-id]]または[[title]]形式のリンクを出現順に重複なく取り出します
+// [[target|表示名]]の場合は|より前を参照先とします。フェンスで囲まれたコードブロック内は無視します
+func ParseWikiLinks(content string) []WikiLink {
+	var links []WikiLink
+	seen := make(map[WikiLink]bool)
+	var fence string
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+
+		for rest := line; ; {
+			start := strings.Index(rest, "[[")
+			if start < 0 {
+				break
+			}
+			rest = rest[start+2:]
+			end := strings.Index(rest, "]]")
+			if end < 0 {
+				break
+			}
+			inner := rest[:end]
+			if strings.Contains(inner, "[") {
+				// [[a [[b]]のような入れ子は内側のリンクを優先します
+				continue
+			}
+			rest = rest[end+2:]
+
+			link, ok := parseWikiLink(inner)
+			if ok && !seen[link] {
+				seen[link] = true
+				links = append(links, link)
+			}
+		}
+	}
+	return links
+}
+
+// parseWikiLink はリンク記法の中身から参照先を取り出します
+func parseWikiLink(inner string) (WikiLink, bool) {
+	if i := strings.Index(inner, "|"); i >= 0 {
+		inner = inner[:i]
+	}
+	target := strings.TrimSpace(inner)
+	if target == "" || utf8.RuneCountInString(target) > MaxTitleLength || validateText(target, false) != "" {
+		return WikiLink{}, false
+	}
+
+	if id, err := strconv.ParseInt(target, 10, 64); err == nil && isDigits(target) {
+		if id <= 0 {
+			return WikiLink{}, false
+		}
+		return WikiLink{NoteID: id}, true
+	}
+	return WikiLink{Title: target}, true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,23 @@
 package domain
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTitleLength はタイトルの最大文字数（rune数）です（notes.title VARCHAR(255)に対応）
+	MaxTitleLength = 255
+	// MaxContentBytes は本文の最大バイト数です（notes.content TEXTに対応）
+	MaxContentBytes = 65535
+)
+
+// minCreatedAt はnotes.created_at（TIMESTAMP型）に保存できる最も古い日時です
+var minCreatedAt = time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC)
 
 // Note はドメイン層のノートエンティティを表します
 type Note struct {
@@ -8,12 +25,133 @@ type Note struct {
 	Title     string    `json:"title"`
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Version は更新のたびに1ずつ増える楽観的排他制御用のバージョンです
+	Version int64 `json:"version"`
+	// DeletedAt はゴミ箱に移動された日時です。ゴミ箱にない場合はnilです
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Tags は正規化済みのタグです
+	Tags []string `json:"tags"`
+	// NotebookID はノートが属するノートブックのIDです。どのノートブックにも属さない場合は0です
+	NotebookID int64 `json:"notebook_id"`
+	// Pinned はノートが一覧の先頭に固定されているかどうかです
+	Pinned bool `json:"pinned"`
+	// Archived はノートがアーカイブされているかどうかです。アーカイブされたノートは既定では一覧に含まれません
+	Archived bool `json:"archived"`
 }
 
-// NewNote は新しいNoteインスタンスを作成します
-func NewNote(title, content string) *Note {
+// NewNote はドメインルールを検証して新しいNoteインスタンスを作成します
+// タグは正規化されます。ルールに違反した場合は*ValidationErrorを返します
+func NewNote(title, content string, tags []string, notebookID int64) (*Note, error) {
+	if err := ValidateNote(title, content); err != nil {
+		return nil, err
+	}
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if notebookID < 0 {
+		return nil, &ValidationError{Violations: []FieldViolation{{Field: "notebook_id", Description: "must not be negative"}}}
+	}
 	return &Note{
-		Title:   title,
-		Content: content,
+		Title:      title,
+		Content:    content,
+		Version:    1,
+		Tags:       normalized,
+		NotebookID: notebookID,
+	}, nil
+}
+
+// Update はドメインルールを検証してタイトルと本文を変更します
+func (n *Note) Update(title, content string) error {
+	if err := ValidateNote(title, content); err != nil {
+		return err
+	}
+	n.Title = title
+	n.Content = content
+	return nil
+}
+
+// ValidateNote はノートのタイトルと本文を検証します
+func ValidateNote(title, content string) error {
+	verr := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(title) == "":
+		verr.add("title", "is required")
+	case utf8.RuneCountInString(title) > MaxTitleLength:
+		verr.add("title", fmt.Sprintf("must be at most %d characters", MaxTitleLength))
+	default:
+		if msg := validateText(title, false); msg != "" {
+			verr.add("title", msg)
+		}
+	}
+
+	switch {
+	case strings.TrimSpace(content) == "":
+		verr.add("content", "is required")
+	case len(content) > MaxContentBytes:
+		verr.add("content", fmt.Sprintf("must be at most %d bytes", MaxContentBytes))
+	default:
+		if msg := validateText(content, true); msg != "" {
+			verr.add("content", msg)
+		}
+	}
+
+	return verr.errOrNil()
+}
+
+// ValidateCreatedAt は取り込むノートに指定された作成日時を検証します
+func ValidateCreatedAt(createdAt, now time.Time) error {
+	if createdAt.Before(minCreatedAt) || createdAt.After(now) {
+		return &ValidationError{Violations: []FieldViolation{{Field: "created_at", Description: "must be between 1970-01-01 and now"}}}
 	}
+	return nil
+}
+
+// ContentHash はタイトルと本文から重複の判定に使うSHA-256ハッシュ（16進数の小文字）を計算します
+// notes.content_hashと同じ値になります
+func ContentHash(title, content string) string {
+	sum := sha256.Sum256([]byte(title + "\n" + content))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetTags はタグを正規化して置き換えます
+func (n *Note) SetTags(tags []string) error {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+	n.Tags = normalized
+	return nil
+}
+
+// Pin はノートを固定します。アーカイブされたノートは固定できません
+func (n *Note) Pin() error {
+	if n.Archived {
+		return fmt.Errorf("note with id %d: %w", n.ID, ErrNoteArchived)
+	}
+	n.Pinned = true
+	return nil
+}
+
+// Unpin はノートの固定を解除します
+func (n *Note) Unpin() {
+	n.Pinned = false
+}
+
+// Archive はノートをアーカイブします。固定は解除されます
+func (n *Note) Archive() {
+	n.Archived = true
+	n.Pinned = false
+}
+
+// Unarchive はノートのアーカイブを解除します
+func (n *Note) Unarchive() {
+	n.Archived = false
+}
+
+// IsTrashed はノートがゴミ箱にあるかどうかを返します
+func (n *Note) IsTrashed() bool {
+	return n.DeletedAt != nil
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxNotebookNameLength はノートブック名の最大文字数（rune数）です（notebooks.name VARCHAR(255)に対応）
+const MaxNotebookNameLength = 255
+
+// Notebook はノートをまとめる入れ子可能なフォルダを表します
+type Notebook struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// ParentID は親のノートブックのIDです。最上位の場合は0です
+	ParentID  int64     `json:"parent_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewNotebook はドメインルールを検証して新しいNotebookインスタンスを作成します
+func NewNotebook(name string, parentID int64) (*Notebook, error) {
+	if err := ValidateNotebook(name, parentID); err != nil {
+		return nil, err
+	}
+	return &Notebook{
+		Name:     name,
+		ParentID: parentID,
+	}, nil
+}
+
+// Update はドメインルールを検証して名前と親を変更します
+// 自身を親にする変更のみを検出します。子孫の下への移動は祖先をたどる必要があるため、
+// ユースケース層が祖先をロックしたトランザクション内で検出します
+func (nb *Notebook) Update(name string, parentID int64) error {
+	if err := ValidateNotebook(name, parentID); err != nil {
+		return err
+	}
+	if nb.ID != 0 && parentID == nb.ID {
+		return fmt.Errorf("notebook %d cannot be its own parent: %w", nb.ID, ErrNotebookCycle)
+	}
+	nb.Name = name
+	nb.ParentID = parentID
+	return nil
+}
+
+// ValidateNotebook はノートブックの名前と親を検証します
+func ValidateNotebook(name string, parentID int64) error {
+	verr := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(name) == "":
+		verr.add("name", "is required")
+	case utf8.RuneCountInString(name) > MaxNotebookNameLength:
+		verr.add("name", fmt.Sprintf("must be at most %d characters", MaxNotebookNameLength))
+	default:
+		if msg := validateText(name, false); msg != "" {
+			verr.add("name", msg)
+		}
+	}
+
+	if parentID < 0 {
+		verr.add("parent_id", "must not be negative")
+	}
+
+	return verr.errOrNil()
+}
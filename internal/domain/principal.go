@@ -0,0 +1,10 @@
+package domain
+
+// MaxPrincipalIDLength は主体IDの最大文字数（rune数）です（comments.author VARCHAR(255)に対応）
+const MaxPrincipalIDLength = 255
+
+// Principal は認証されたリクエストの主体を表します
+type Principal struct {
+	// ID は主体を識別する名前です。コメントの作成者などに記録されます
+	ID string `json:"id"`
+}
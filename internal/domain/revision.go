@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// NoteRevision はあるバージョン時点のノートの内容を表します
+type NoteRevision struct {
+	NoteID    int64     `json:"note_id"`
+	Version   int64     `json:"version"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
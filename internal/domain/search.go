@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetRadius はスニペットで一致箇所の前後に含める文字数です
+const snippetRadius = 60
+
+// HighlightTags は一致箇所を囲むタグと、それ以外のテキストをHTMLエスケープするかを表します
+type HighlightTags struct {
+	Pre        string
+	Post       string
+	EscapeHTML bool
+}
+
+// DefaultHighlightTags は既定の強調タグです。スニペットをそのままHTMLに埋め込めるよう本文をエスケープします
+var DefaultHighlightTags = HighlightTags{Pre: "<mark>", Post: "</mark>", EscapeHTML: true}
+
+// SearchHit は検索にヒットしたノートと関連度を表します
+type SearchHit struct {
+	Note           *Note
+	Score          float64
+	TitleSnippet   string
+	ContentSnippet string
+}
+
+// SearchTerms は検索クエリを空白で区切った語の一覧を返します
+func SearchTerms(query string) []string {
+	return strings.FieldsFunc(query, unicode.IsSpace)
+}
+
+// Highlight はtext中のtermsに一致する箇所をtagsで囲みます（大文字小文字は区別しません）
+func Highlight(text string, terms []string, tags HighlightTags) string {
+	runes := []rune(text)
+	return highlightRange(runes, matchRanges(runes, terms), 0, len(runes), tags)
+}
+
+// Snippet は最初に一致した箇所の前後を切り出し、一致箇所を強調したスニペットを返します
+// 一致がない場合は先頭から切り出します
+func Snippet(text string, terms []string, tags HighlightTags) string {
+	runes := []rune(text)
+	ranges := matchRanges(runes, terms)
+
+	start := 0
+	if len(ranges) > 0 {
+		start = ranges[0][0] - snippetRadius
+		if start < 0 {
+			start = 0
+		}
+	}
+	end := start + snippetRadius*2
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	snippet := highlightRange(runes, ranges, start, end, tags)
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// matchRanges はtermsに一致する重ならない範囲（rune単位の[開始, 終了)）を出現順に返します
+func matchRanges(runes []rune, terms []string) [][2]int {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var needles [][]rune
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) > 0 {
+			needles = append(needles, needle)
+		}
+	}
+
+	var ranges [][2]int
+	for i := 0; i < len(lower); {
+		matched := 0
+		for _, needle := range needles {
+			if len(needle) > matched && hasRunePrefix(lower[i:], needle) {
+				matched = len(needle)
+			}
+		}
+		if matched == 0 {
+			i++
+			continue
+		}
+		ranges = append(ranges, [2]int{i, i + matched})
+		i += matched
+	}
+	return ranges
+}
+
+func hasRunePrefix(s, prefix []rune) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// highlightRange はrunes[start:end]のうち一致範囲をtagsで囲んだ文字列を返します
+// tags.EscapeHTMLの場合、タグ以外のテキストはHTMLエスケープします
+func highlightRange(runes []rune, ranges [][2]int, start, end int, tags HighlightTags) string {
+	text := func(rs []rune) string {
+		if tags.EscapeHTML {
+			return html.EscapeString(string(rs))
+		}
+		return string(rs)
+	}
+
+	var b strings.Builder
+	pos := start
+	for _, r := range ranges {
+		from, to := r[0], r[1]
+		if to <= start || from >= end {
+			continue
+		}
+		if from < start {
+			from = start
+		}
+		if to > end {
+			to = end
+		}
+		b.WriteString(text(runes[pos:from]))
+		b.WriteString(tags.Pre)
+		b.WriteString(text(runes[from:to]))
+		b.WriteString(tags.Post)
+		pos = to
+	}
+	b.WriteString(text(runes[pos:end]))
+	return b.String()
+}
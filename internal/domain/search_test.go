@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		tags  HighlightTags
+		want  string
+	}{
+		{
+			name:  "case insensitive",
+			text:  "Go and go",
+			terms: []string{"GO"},
+			tags:  DefaultHighlightTags,
+			want:  "<mark>Go</mark> and <mark>go</mark>",
+		},
+		{
+			name:  "escapes markup with default tags",
+			text:  `<script>alert("x")</script> & <b>go</b>`,
+			terms: []string{"go"},
+			tags:  DefaultHighlightTags,
+			want:  `&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; &lt;b&gt;<mark>go</mark>&lt;/b&gt;`,
+		},
+		{
+			name:  "escapes matched text",
+			text:  "a<b>c",
+			terms: []string{"<b>"},
+			tags:  DefaultHighlightTags,
+			want:  "a<mark>&lt;b&gt;</mark>c",
+		},
+		{
+			name:  "custom tags return plain text",
+			text:  "<i>go</i>",
+			terms: []string{"go"},
+			tags:  HighlightTags{Pre: "[", Post: "]"},
+			want:  "<i>[go]</i>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Highlight(tt.text, tt.terms, tt.tags); got != tt.want {
+				t.Errorf("Highlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnippetEscapesWithoutSplittingEntities(t *testing.T) {
+	// 切り出し位置の直前後に&を置き、エスケープ後の実体参照が途中で切れないことを確認します
+	text := strings.Repeat("&", 100) + "needle" + strings.Repeat("<", 100)
+	got := Snippet(text, []string{"needle"}, DefaultHighlightTags)
+
+	want := "…" + strings.Repeat("&amp;", snippetRadius) + "<mark>needle</mark>" + strings.Repeat("&lt;", snippetRadius-len("needle")) + "…"
+	if got != want {
+		t.Errorf("Snippet() = %q, want %q", got, want)
+	}
+}
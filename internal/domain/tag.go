@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxTagLength はタグの最大文字数（rune数）です
+	MaxTagLength = 64
+	// MaxTagsPerNote は1つのノートに付けられるタグの最大数です
+	MaxTagsPerNote = 20
+)
+
+// TagCount はタグとそのタグが付いたノート数を表します
+type TagCount struct {
+	Name  string
+	Count int64
+}
+
+// NormalizeTag はタグを正規化します
+// NFKCで全角英数字を半角に、半角カナを全角に揃え、大文字小文字を畳み込み、連続する空白を1つにまとめます
+func NormalizeTag(tag string) string {
+	tag = norm.NFKC.String(tag)
+	tag = strings.ToLower(tag)
+	return strings.Join(strings.FieldsFunc(tag, unicode.IsSpace), " ")
+}
+
+// NormalizeTags はタグを正規化し、重複を除いて昇順で返します
+// 正規化後のタグがルールに違反する場合は*ValidationErrorを返します
+func NormalizeTags(tags []string) ([]string, error) {
+	verr := &ValidationError{}
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for i, tag := range tags {
+		field := fmt.Sprintf("tags[%d]", i)
+		if !utf8.ValidString(tag) {
+			verr.add(field, "must be valid UTF-8")
+			continue
+		}
+
+		t := NormalizeTag(tag)
+		switch {
+		case t == "":
+			verr.add(field, "must not be empty")
+		case utf8.RuneCountInString(t) > MaxTagLength:
+			verr.add(field, fmt.Sprintf("must be at most %d characters", MaxTagLength))
+		default:
+			if msg := validateText(t, false); msg != "" {
+				verr.add(field, msg)
+			} else if !seen[t] {
+				seen[t] = true
+				normalized = append(normalized, t)
+			}
+		}
+	}
+
+	if len(normalized) > MaxTagsPerNote {
+		verr.add("tags", fmt.Sprintf("must contain at most %d tags", MaxTagsPerNote))
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
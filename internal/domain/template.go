@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTemplateNameLength はテンプレート名の最大文字数（rune数）です
+	MaxTemplateNameLength = 255
+	// MaxTitleTemplateLength はタイトルのテンプレートの最大文字数（rune数）です
+	MaxTitleTemplateLength = 1024
+	// MaxTemplateVars はテンプレートに渡せる変数の最大数です
+	MaxTemplateVars = 100
+	// templateRenderTimeout はテンプレート1つの実行にかけられる最大時間です
+	templateRenderTimeout = time.Second
+)
+
+// NoteTemplate はノートを作成する際のひな形を表します
+// タイトルと本文はtext/templateの構文で記述し、許可された関数のみ使用できます
+type NoteTemplate struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	TitleTemplate   string    `json:"title_template"`
+	ContentTemplate string    `json:"content_template"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TemplateData はテンプレートに渡す値を表します
+type TemplateData struct {
+	// Now は{{.Date}}、{{.Time}}、{{date "..."}}の基準となる日時です
+	Now time.Time
+	// Author は{{.Author}}で参照する作成者（認証された主体のID）です
+	Author string
+	// Vars は{{.Vars.name}}または{{var "name"}}で参照する任意の変数です
+	Vars map[string]string
+}
+
+// templateFuncs はテンプレートで使用できる関数です。組み込み関数のうち許可するものはallowedBuiltinsに列挙します
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"default": func(def, value string) string {
+		if value == "" {
+			return def
+		}
+		return value
+	},
+	// date とvar は実行時にTemplateDataを参照する関数で置き換えます
+	"date": func(layout string) string { return "" },
+	"var":  func(name string) string { return "" },
+}
+
+// allowedBuiltins はテンプレートで使用できるtext/templateの組み込み関数です
+// printfなど出力サイズを制御できない関数やcallは使用できません
+var allowedBuiltins = map[string]bool{
+	"and": true, "or": true, "not": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"len": true, "index": true,
+}
+
+// NewNoteTemplate はドメインルールを検証して新しいNoteTemplateインスタンスを作成します
+func NewNoteTemplate(name, titleTemplate, contentTemplate string) (*NoteTemplate, error) {
+	if err := ValidateNoteTemplate(name, titleTemplate, contentTemplate); err != nil {
+		return nil, err
+	}
+	return &NoteTemplate{
+		Name:            name,
+		TitleTemplate:   titleTemplate,
+		ContentTemplate: contentTemplate,
+	}, nil
+}
+
+// Update はドメインルールを検証して名前とテンプレートを変更します
+func (t *NoteTemplate) Update(name, titleTemplate, contentTemplate string) error {
+	if err := ValidateNoteTemplate(name, titleTemplate, contentTemplate); err != nil {
+		return err
+	}
+	t.Name = name
+	t.TitleTemplate = titleTemplate
+	t.ContentTemplate = contentTemplate
+	return nil
+}
+
+// ValidateNoteTemplate はテンプレートの名前と構文を検証します
+func ValidateNoteTemplate(name, titleTemplate, contentTemplate string) error {
+	verr := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(name) == "":
+		verr.add("name", "is required")
+	case utf8.RuneCountInString(name) > MaxTemplateNameLength:
+		verr.add("name", fmt.Sprintf("must be at most %d characters", MaxTemplateNameLength))
+	default:
+		if msg := validateText(name, false); msg != "" {
+			verr.add("name", msg)
+		}
+	}
+
+	switch {
+	case strings.TrimSpace(titleTemplate) == "":
+		verr.add("title_template", "is required")
+	case utf8.RuneCountInString(titleTemplate) > MaxTitleTemplateLength:
+		verr.add("title_template", fmt.Sprintf("must be at most %d characters", MaxTitleTemplateLength))
+	default:
+		if msg := validateText(titleTemplate, false); msg != "" {
+			verr.add("title_template", msg)
+		} else if _, err := parseTemplate("title", titleTemplate); err != nil {
+			verr.add("title_template", err.Error())
+		}
+	}
+
+	switch {
+	case strings.TrimSpace(contentTemplate) == "":
+		verr.add("content_template", "is required")
+	case len(contentTemplate) > MaxContentBytes:
+		verr.add("content_template", fmt.Sprintf("must be at most %d bytes", MaxContentBytes))
+	default:
+		if msg := validateText(contentTemplate, true); msg != "" {
+			verr.add("content_template", msg)
+		} else if _, err := parseTemplate("content", contentTemplate); err != nil {
+			verr.add("content_template", err.Error())
+		}
+	}
+
+	return verr.errOrNil()
+}
+
+// Render はテンプレートにdataを当てはめてノートのタイトルと本文を生成します
+// 未定義の変数を参照した場合や生成結果がノートのルールに違反する場合は*ValidationErrorを返します
+// 各テンプレートの実行はctxの期限またはtemplateRenderTimeoutのうち早い方で打ち切ります
+func (t *NoteTemplate) Render(ctx context.Context, data TemplateData) (title, content string, err error) {
+	if len(data.Vars) > MaxTemplateVars {
+		return "", "", &ValidationError{Violations: []FieldViolation{
+			{Field: "variables", Description: fmt.Sprintf("must contain at most %d entries", MaxTemplateVars)},
+		}}
+	}
+
+	verr := &ValidationError{}
+
+	title, err = executeTemplate(ctx, "title", t.TitleTemplate, data)
+	if err != nil {
+		verr.add("variables", err.Error())
+	}
+	content, err = executeTemplate(ctx, "content", t.ContentTemplate, data)
+	if err != nil {
+		verr.add("variables", err.Error())
+	}
+	if err := verr.errOrNil(); err != nil {
+		return "", "", err
+	}
+
+	if err := ValidateNote(title, content); err != nil {
+		return "", "", err
+	}
+	return title, content, nil
+}
+
+// parseTemplate はテンプレートを解析し、許可されていない関数や構文を使用していないことを確認します
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree == nil {
+			continue
+		}
+		if err := checkTemplateNode(t.Tree.Root, false); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// checkTemplateNode は構文木をたどって使用できない関数と構文を検出します
+// rangeは実行時間を変数の数で抑えるため、.Varsに対してだけ、入れ子にせずに使用できます
+func checkTemplateNode(node parse.Node, inRange bool) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplateNode(child, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkTemplateNode(n.Pipe, inRange)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := checkTemplateNode(cmd, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if err := checkTemplateNode(arg, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.IdentifierNode:
+		if _, ok := templateFuncs[n.Ident]; !ok && !allowedBuiltins[n.Ident] {
+			return fmt.Errorf("function %q is not allowed", n.Ident)
+		}
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode, inRange)
+	case *parse.RangeNode:
+		if inRange {
+			return errors.New("nested range is not allowed")
+		}
+		if !isVarsPipe(n.Pipe) {
+			return errors.New("range is only allowed over .Vars")
+		}
+		return checkBranch(&n.BranchNode, true)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode, inRange)
+	case *parse.TemplateNode:
+		return errors.New("template invocation is not allowed")
+	case *parse.ChainNode:
+		return checkTemplateNode(n.Node, inRange)
+	}
+	return nil
+}
+
+func checkBranch(n *parse.BranchNode, inRange bool) error {
+	if err := checkTemplateNode(n.Pipe, inRange); err != nil {
+		return err
+	}
+	if err := checkTemplateNode(n.List, inRange); err != nil {
+		return err
+	}
+	return checkTemplateNode(n.ElseList, inRange)
+}
+
+// isVarsPipe はパイプラインが.Vars（変数への代入を含む）だけであるかどうかを返します
+func isVarsPipe(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	field, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	return ok && len(field.Ident) == 1 && field.Ident[0] == "Vars"
+}
+
+// executeTemplate はテンプレートを実行します。生成結果が本文の上限を超えた時点で中断します
+// text/templateの実行は途中で止められないため、期限を過ぎた場合は実行を待たずにエラーを返します
+// （構文の制限により実行時間には上限があるため、残った実行もいずれ終わります）
+func executeTemplate(ctx context.Context, name, text string, data TemplateData) (string, error) {
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	tmpl.Funcs(template.FuncMap{
+		"date": func(layout string) string { return data.Now.Format(layout) },
+		"var": func(key string) (string, error) {
+			value, ok := data.Vars[key]
+			if !ok {
+				return "", fmt.Errorf("variable %q is not set", key)
+			}
+			return value, nil
+		},
+	})
+
+	vars := data.Vars
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	values := map[string]interface{}{
+		"Date":   data.Now.Format("2006-01-02"),
+		"Time":   data.Now.Format("15:04"),
+		"Author": data.Author,
+		"Vars":   vars,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, templateRenderTimeout)
+	defer cancel()
+
+	out := &limitedBuffer{ctx: ctx, limit: MaxContentBytes}
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(out, values)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", err
+		}
+		return out.String(), nil
+	case <-ctx.Done():
+		return "", errTemplateTimeout
+	}
+}
+
+// errTemplateTimeout はテンプレートの実行が期限内に終わらなかった場合に返されます
+var errTemplateTimeout = errors.New("rendering the template took too long")
+
+// errTemplateOutputTooLarge はテンプレートの生成結果が上限を超えた場合に返されます
+var errTemplateOutputTooLarge = fmt.Errorf("rendered template must be at most %d bytes", MaxContentBytes)
+
+// limitedBuffer は書き込み量がlimitを超えるか、ctxが終了するとエラーを返すバッファです
+type limitedBuffer struct {
+	bytes.Buffer
+	ctx   context.Context
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if err := b.ctx.Err(); err != nil {
+		return 0, errTemplateTimeout
+	}
+	if b.Len()+len(p) > b.limit {
+		return 0, errTemplateOutputTooLarge
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FieldViolation は単一フィールドの検証エラーを表します
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError はドメインルールに違反したフィールドの一覧を保持します
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Error はerrorインターフェースを実装します
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// add は違反を追加します
+func (e *ValidationError) add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+// errOrNil は違反がある場合にのみエラーを返します
+func (e *ValidationError) errOrNil() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+// validateText は文字列フィールドに共通するルールを検証し、最初の違反を返します
+// allowLineBreaksがtrueの場合は改行とタブを許可します
+func validateText(value string, allowLineBreaks bool) string {
+	if !utf8.ValidString(value) {
+		return "must be valid UTF-8"
+	}
+	for _, r := range value {
+		if allowLineBreaks && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+		if unicode.IsControl(r) {
+			return "must not contain control characters"
+		}
+	}
+	return ""
+}
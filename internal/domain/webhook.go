@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxWebhookURLLength はWebhookの送信先URLの最大文字数（rune数）です
+	MaxWebhookURLLength = 2048
+	// MinWebhookSecretLength はWebhookの署名用シークレットの最小文字数（rune数）です
+	MinWebhookSecretLength = 16
+	// MaxWebhookSecretLength はWebhookの署名用シークレットの最大文字数（rune数）です
+	MaxWebhookSecretLength = 255
+)
+
+// webhookEventTypes はWebhookで購読できるイベントの種類です
+var webhookEventTypes = map[NoteEventType]bool{
+	NoteCreated:  true,
+	NoteUpdated:  true,
+	NoteDeleted:  true,
+	NoteRestored: true,
+	NotePurged:   true,
+}
+
+// Webhook はノートの変更イベントをHTTPで通知する送信先の登録を表します
+type Webhook struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+	// EventTypes は通知するイベントの種類です。空の場合はすべてのイベントを通知します
+	EventTypes []NoteEventType `json:"event_types"`
+	// Secret はペイロードの署名に使うシークレットです
+	Secret    string    `json:"-"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewWebhook はドメインルールを検証して新しい有効なWebhookインスタンスを作成します
+func NewWebhook(rawURL string, eventTypes []NoteEventType, secret string) (*Webhook, error) {
+	if err := ValidateWebhook(rawURL, eventTypes, secret); err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		URL:        rawURL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Active:     true,
+	}, nil
+}
+
+// Update はドメインルールを検証して送信先・イベントの種類・有効状態を変更します
+// secretが空の場合はシークレットを変更しません
+func (w *Webhook) Update(rawURL string, eventTypes []NoteEventType, secret string, active bool) error {
+	if secret == "" {
+		secret = w.Secret
+	}
+	if err := ValidateWebhook(rawURL, eventTypes, secret); err != nil {
+		return err
+	}
+	w.URL = rawURL
+	w.EventTypes = eventTypes
+	w.Secret = secret
+	w.Active = active
+	return nil
+}
+
+// Matches はイベントの種類がこのWebhookの通知対象かどうかを返します
+func (w *Webhook) Matches(eventType NoteEventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWebhook はWebhookの送信先・イベントの種類・シークレットを検証します
+func ValidateWebhook(rawURL string, eventTypes []NoteEventType, secret string) error {
+	verr := &ValidationError{}
+
+	if utf8.RuneCountInString(rawURL) > MaxWebhookURLLength {
+		verr.add("url", fmt.Sprintf("must be at most %d characters", MaxWebhookURLLength))
+	} else if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.add("url", "must be an absolute http or https URL")
+	} else if u.User != nil {
+		verr.add("url", "must not contain credentials")
+	}
+
+	seen := make(map[NoteEventType]bool, len(eventTypes))
+	for i, t := range eventTypes {
+		field := fmt.Sprintf("event_types[%d]", i)
+		switch {
+		case !webhookEventTypes[t]:
+			verr.add(field, "must be a known event type")
+		case seen[t]:
+			verr.add(field, "must be unique")
+		}
+		seen[t] = true
+	}
+
+	n := utf8.RuneCountInString(secret)
+	switch {
+	case n < MinWebhookSecretLength:
+		verr.add("secret", fmt.Sprintf("must be at least %d characters", MinWebhookSecretLength))
+	case n > MaxWebhookSecretLength:
+		verr.add("secret", fmt.Sprintf("must be at most %d characters", MaxWebhookSecretLength))
+	}
+
+	return verr.errOrNil()
+}
+
+// WebhookDeliveryStatus はWebhookの送信状況を表します
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending は送信待ち（再送待ちを含む）であることを表します
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded は送信先が2xxを返したことを表します
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed は再送の上限に達して送信を打ち切ったことを表します
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery は1つのイベントを1つのWebhookに送信した記録を表します
+type WebhookDelivery struct {
+	ID        int64                 `json:"id"`
+	WebhookID int64                 `json:"webhook_id"`
+	Event     NoteEvent             `json:"event"`
+	Status    WebhookDeliveryStatus `json:"status"`
+	// Attempts はこれまでに送信を試みた回数です
+	Attempts int `json:"attempts"`
+	// LastStatusCode は最後の送信で送信先が返したHTTPステータスコードです。応答がなかった場合は0です
+	LastStatusCode int        `json:"last_status_code"`
+	LastError      string     `json:"last_error"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// webhookPayload はWebhookで送信するJSONの形式です
+type webhookPayload struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	NoteID     int64     `json:"note_id"`
+	Version    int64     `json:"version"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// WebhookEventName はWebhookで通知するイベント名（"note.created"など）を返します
+func WebhookEventName(eventType NoteEventType) string {
+	return "note." + string(eventType)
+}
+
+// Payload は送信するJSONを返します。idはイベントIDで、再送や重複した配信でも同じ値になります
+func (d *WebhookDelivery) Payload() ([]byte, error) {
+	return json.Marshal(webhookPayload{
+		ID:         d.Event.ID,
+		Type:       WebhookEventName(d.Event.Type),
+		NoteID:     d.Event.NoteID,
+		Version:    d.Event.Version,
+		OccurredAt: d.Event.OccurredAt.UTC(),
+	})
+}
+
+// SignWebhookPayload は"<timestamp>.<body>"のHMAC-SHA256を"sha256=<16進数>"の形式で返します
+// タイムスタンプを署名に含めることで、受信側は古いリクエストの再送（リプレイ）を拒否できます
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,213 @@
+package archive
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// blankLines は3行以上続く空行を1行の空行にまとめるための正規表現です
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// enmlToMarkdown はEvernoteのノートの本文（ENML）をMarkdownに変換します
+// 見出し、段落、リスト、チェックボックス、リンク、強調、コードに対応し、それ以外の要素は中のテキストだけを残します
+func enmlToMarkdown(enml string) string {
+	c := &enmlConverter{}
+	z := html.NewTokenizer(strings.NewReader(enml))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// 入力の終わりです（ENMLは文字列として読み込み済みのため、読み取りエラーは起きません）
+			c.trimSpaces()
+			out := blankLines.ReplaceAllString(string(c.b), "\n\n")
+			return strings.TrimSpace(out)
+		case html.TextToken:
+			c.text(string(z.Text()))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			c.start(z.Token())
+		case html.EndTagToken:
+			c.end(z.Token())
+		}
+	}
+}
+
+// enmlConverter はENMLのトークンを順に受け取ってMarkdownを組み立てます
+type enmlConverter struct {
+	b []byte
+	// lists は入れ子になったリストごとの番号です（箇条書きの場合は-1）
+	lists []int
+	pre   int
+	hrefs []string
+	// marker はリストの記号や見出しの記号を書き込んだ直後の出力の長さです
+	marker int
+}
+
+func (c *enmlConverter) start(t html.Token) {
+	switch t.DataAtom {
+	case atom.Div, atom.P, atom.Blockquote, atom.Tr, atom.Table:
+		c.newline(1)
+	case atom.Br:
+		c.write("\n")
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.newline(2)
+		c.write(strings.Repeat("#", int(t.Data[1]-'0')) + " ")
+		c.marker = len(c.b)
+	case atom.Ul:
+		c.newline(1)
+		c.lists = append(c.lists, -1)
+	case atom.Ol:
+		c.newline(1)
+		c.lists = append(c.lists, 0)
+	case atom.Li:
+		c.newline(1)
+		switch {
+		case len(c.lists) == 0:
+			c.write("- ")
+		case c.lists[len(c.lists)-1] >= 0:
+			c.lists[len(c.lists)-1]++
+			c.write(strings.Repeat("  ", len(c.lists)-1) + strconv.Itoa(c.lists[len(c.lists)-1]) + ". ")
+		default:
+			c.write(strings.Repeat("  ", len(c.lists)-1) + "- ")
+		}
+		c.marker = len(c.b)
+	case atom.Hr:
+		c.newline(2)
+		c.write("---\n")
+	case atom.Pre:
+		c.newline(1)
+		c.write("```\n")
+		c.pre++
+	case atom.Code:
+		if c.pre == 0 {
+			c.write("`")
+		}
+	case atom.B, atom.Strong:
+		c.write("**")
+	case atom.I, atom.Em:
+		c.write("*")
+	case atom.A:
+		c.write("[")
+		c.hrefs = append(c.hrefs, attr(t, "href"))
+	case atom.Td, atom.Th:
+		c.write(" ")
+	default:
+		if t.Data == "en-todo" {
+			if attr(t, "checked") == "true" {
+				c.write("[x] ")
+			} else {
+				c.write("[ ] ")
+			}
+		}
+	}
+}
+
+func (c *enmlConverter) end(t html.Token) {
+	switch t.DataAtom {
+	case atom.Div, atom.Tr, atom.Table:
+		c.newline(1)
+	case atom.P, atom.Blockquote, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.newline(2)
+	case atom.Ul, atom.Ol:
+		if len(c.lists) > 0 {
+			c.lists = c.lists[:len(c.lists)-1]
+		}
+		c.newline(1)
+	case atom.Pre:
+		c.newline(1)
+		c.write("```\n")
+		if c.pre > 0 {
+			c.pre--
+		}
+	case atom.Code:
+		if c.pre == 0 {
+			c.write("`")
+		}
+	case atom.B, atom.Strong:
+		c.write("**")
+	case atom.I, atom.Em:
+		c.write("*")
+	case atom.A:
+		href := ""
+		if len(c.hrefs) > 0 {
+			href = c.hrefs[len(c.hrefs)-1]
+			c.hrefs = c.hrefs[:len(c.hrefs)-1]
+		}
+		c.write("](" + href + ")")
+	case atom.Td, atom.Th:
+		c.write(" |")
+	}
+}
+
+// text はテキストを書き込みます。<pre>の外では連続する空白を1つにまとめます
+func (c *enmlConverter) text(s string) {
+	if c.pre > 0 {
+		c.write(s)
+		return
+	}
+	collapsed := strings.Join(strings.Fields(s), " ")
+	if collapsed == "" {
+		if s != "" {
+			c.space()
+		}
+		return
+	}
+	if strings.TrimLeftFunc(s, unicode.IsSpace) != s {
+		c.space()
+	}
+	c.write(collapsed)
+	if strings.TrimRightFunc(s, unicode.IsSpace) != s {
+		c.space()
+	}
+}
+
+// write は出力の末尾に書き込みます
+func (c *enmlConverter) write(s string) {
+	c.b = append(c.b, s...)
+}
+
+// space は行頭でなく空白で終わっていない場合に空白を1つ書き込みます
+func (c *enmlConverter) space() {
+	if n := len(c.b); n > 0 && c.b[n-1] != '\n' && c.b[n-1] != ' ' {
+		c.b = append(c.b, ' ')
+	}
+}
+
+// trimSpaces は出力の末尾の空白を取り除きます
+func (c *enmlConverter) trimSpaces() {
+	for len(c.b) > 0 && c.b[len(c.b)-1] == ' ' {
+		c.b = c.b[:len(c.b)-1]
+	}
+}
+
+// newline は出力の末尾がn個以上の改行で終わるようにします。出力が空の場合は何もしません
+// リストの項目の中の<div>などでは、記号の直後で改行しません
+func (c *enmlConverter) newline(n int) {
+	if len(c.b) == c.marker {
+		return
+	}
+	c.trimSpaces()
+	if len(c.b) == 0 {
+		return
+	}
+	have := 0
+	for have < len(c.b) && c.b[len(c.b)-1-have] == '\n' {
+		have++
+	}
+	for ; have < n; have++ {
+		c.b = append(c.b, '\n')
+	}
+}
+
+// attr はトークンの属性の値を返します
+func attr(t html.Token, key string) string {
+	for _, a := range t.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
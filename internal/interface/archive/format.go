@@ -0,0 +1,65 @@
+package archive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format はノートのアーカイブ形式を表します
+type Format string
+
+const (
+	// FormatMarkdownZip はYAMLフロントマター付きのMarkdownファイルをまとめたzipアーカイブです
+	FormatMarkdownZip Format = "markdown-zip"
+	// FormatMarkdownTar はYAMLフロントマター付きのMarkdownファイルをまとめたgzip圧縮のtarアーカイブです
+	FormatMarkdownTar Format = "markdown-tar"
+	// FormatNDJSON は1行に1ノートのJSONオブジェクトを書く形式です
+	FormatNDJSON Format = "ndjson"
+	// FormatCSV はヘッダー行付きのCSVです
+	FormatCSV Format = "csv"
+)
+
+// Formats はサポートしているすべての形式です
+var Formats = []Format{FormatMarkdownZip, FormatMarkdownTar, FormatNDJSON, FormatCSV}
+
+// ParseFormat は形式名を解析します
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == strings.ToLower(name) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown archive format %q", name)
+}
+
+// ContentType はアーカイブのMIMEタイプを返します
+func (f Format) ContentType() string {
+	switch f {
+	case FormatMarkdownZip:
+		return "application/zip"
+	case FormatMarkdownTar:
+		return "application/gzip"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatCSV:
+		return "text/csv"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Extension はアーカイブのファイル名に付ける拡張子（先頭のドットを含む）を返します
+func (f Format) Extension() string {
+	switch f {
+	case FormatMarkdownZip:
+		return ".zip"
+	case FormatMarkdownTar:
+		return ".tar.gz"
+	case FormatNDJSON:
+		return ".ndjson"
+	case FormatCSV:
+		return ".csv"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,335 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// maxNDJSONLineBytes はNDJSONの1行の最大バイト数です
+const maxNDJSONLineBytes = 1 << 20
+
+// ErrUnsupportedFile はインポートできない種類のファイルであることを示します
+var ErrUnsupportedFile = errors.New("unsupported file type")
+
+// Entry はインポートするファイルから読み取った1件のノートです
+type Entry struct {
+	Title   string
+	Content string
+	Tags    []string
+	// CreatedAt は元の作成日時です。ファイルに含まれていない場合はゼロ値です
+	CreatedAt time.Time
+}
+
+// Supported はファイル名の拡張子がインポートできる形式かどうかを返します
+func Supported(filename string) bool {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".md", ".markdown", ".enex", ".ndjson", ".jsonl", ".csv":
+		return true
+	default:
+		return false
+	}
+}
+
+// Decode はファイル名の拡張子から形式を判定して、ファイルの内容をノートに変換します
+// Markdown（.md、.markdown）は1ファイルを1件のノートとして、ENEX（.enex）、NDJSON（.ndjson、.jsonl）、CSV（.csv）は複数のノートとして読み取ります
+func Decode(filename string, data []byte) ([]Entry, error) {
+	switch ext := strings.ToLower(path.Ext(filename)); ext {
+	case ".md", ".markdown":
+		entry, err := DecodeMarkdown(strings.TrimSuffix(path.Base(filename), path.Ext(filename)), data)
+		if err != nil {
+			return nil, err
+		}
+		return []Entry{entry}, nil
+	case ".enex":
+		return DecodeENEX(data)
+	case ".ndjson", ".jsonl":
+		return DecodeNDJSON(data)
+	case ".csv":
+		return DecodeCSV(data)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedFile, ext)
+	}
+}
+
+// DecodeMarkdown はYAMLフロントマター付きのMarkdownをノートに変換します
+// フロントマターのtitle、created（またはdate）、tags（またはtag）を読み取り、それ以外のキーは無視します
+// タイトルがない場合はdefaultTitle（通常は拡張子を除いたファイル名）を使います
+func DecodeMarkdown(defaultTitle string, data []byte) (Entry, error) {
+	text := strings.TrimPrefix(string(data), "\ufeff")
+	entry := Entry{Title: defaultTitle, Content: text}
+
+	lines, body, ok := splitFrontMatter(text)
+	if !ok {
+		return entry, nil
+	}
+	entry.Content = body
+
+	for i := 0; i < len(lines); i++ {
+		key, value, found := strings.Cut(lines[i], ":")
+		if !found || strings.HasPrefix(lines[i], " ") || strings.HasPrefix(lines[i], "#") {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "title":
+			if title := parseScalar(value); title != "" {
+				entry.Title = title
+			}
+		case "created", "date":
+			t, err := parseTime(parseScalar(value))
+			if err != nil {
+				return entry, fmt.Errorf("invalid %s in front matter: %w", key, err)
+			}
+			entry.CreatedAt = t
+		case "tags", "tag":
+			if value != "" {
+				entry.Tags = append(entry.Tags, parseList(value)...)
+				continue
+			}
+			// ブロック形式のリスト（"- タグ"の行）です
+			for i+1 < len(lines) {
+				item := strings.TrimSpace(lines[i+1])
+				if !strings.HasPrefix(item, "-") {
+					break
+				}
+				if tag := parseScalar(strings.TrimSpace(strings.TrimPrefix(item, "-"))); tag != "" {
+					entry.Tags = append(entry.Tags, tag)
+				}
+				i++
+			}
+		}
+	}
+	for i, tag := range entry.Tags {
+		// Obsidianなどの"#タグ"の形式に対応します
+		entry.Tags[i] = strings.TrimPrefix(tag, "#")
+	}
+	return entry, nil
+}
+
+// splitFrontMatter は先頭の"---"の行から次の"---"（または"..."）の行までをフロントマターの行として分けます
+// フロントマターの直後の空行は1行だけ取り除きます
+func splitFrontMatter(text string) (lines []string, body string, ok bool) {
+	first, rest, found := strings.Cut(text, "\n")
+	if !found || strings.TrimRight(first, "\r") != "---" {
+		return nil, text, false
+	}
+	for rest != "" {
+		var line string
+		line, rest, _ = strings.Cut(rest, "\n")
+		line = strings.TrimRight(line, "\r")
+		if line == "---" || line == "..." {
+			if strings.HasPrefix(rest, "\r\n") {
+				rest = rest[2:]
+			} else {
+				rest = strings.TrimPrefix(rest, "\n")
+			}
+			return lines, rest, true
+		}
+		lines = append(lines, line)
+	}
+	// 閉じる行がない場合はフロントマターとして扱いません
+	return nil, text, false
+}
+
+// parseScalar はYAMLのスカラー値（ダブルクォート、シングルクォート、クォートなし）を文字列にします
+func parseScalar(value string) string {
+	switch {
+	case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+		var s string
+		if err := json.Unmarshal([]byte(value), &s); err == nil {
+			return s
+		}
+		return value[1 : len(value)-1]
+	case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+	default:
+		// 行末のコメントを取り除きます
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = value[:i]
+		}
+		return strings.TrimSpace(value)
+	}
+}
+
+// parseList はフロー形式のリスト（"[a, "b"]"）またはカンマ区切りの値を文字列のリストにします
+func parseList(value string) []string {
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		value = value[1 : len(value)-1]
+	}
+
+	var items []string
+	var quote rune
+	start := 0
+	for i, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ',':
+			items = appendScalar(items, value[start:i])
+			start = i + 1
+		}
+	}
+	return appendScalar(items, value[start:])
+}
+
+// appendScalar は空でないスカラー値をリストに追加します
+func appendScalar(items []string, value string) []string {
+	if s := parseScalar(strings.TrimSpace(value)); s != "" {
+		items = append(items, s)
+	}
+	return items
+}
+
+// timeLayouts は作成日時として受け付ける形式です
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+// parseTime は作成日時を解析します。タイムゾーンがない場合はUTCとして扱います
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", value)
+}
+
+// enexNote はENEXの<note>要素です。添付ファイル（<resource>）は読み取りません
+type enexNote struct {
+	Title   string   `xml:"title"`
+	Content string   `xml:"content"`
+	Created string   `xml:"created"`
+	Tags    []string `xml:"tag"`
+}
+
+// DecodeENEX はEvernoteのエクスポートファイル（ENEX）のノートを変換します
+// 本文（ENML）はMarkdownに変換し、添付ファイルは取り込みません
+func DecodeENEX(data []byte) ([]Entry, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var entries []Entry
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid ENEX: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "note" {
+			continue
+		}
+
+		var n enexNote
+		if err := d.DecodeElement(&n, &start); err != nil {
+			return nil, fmt.Errorf("invalid ENEX note %d: %w", len(entries)+1, err)
+		}
+		entry := Entry{
+			Title:   strings.TrimSpace(n.Title),
+			Content: enmlToMarkdown(n.Content),
+			Tags:    n.Tags,
+		}
+		if n.Created != "" {
+			t, err := time.Parse("20060102T150405Z", strings.TrimSpace(n.Created))
+			if err != nil {
+				return nil, fmt.Errorf("invalid ENEX note %d: invalid created %q", len(entries)+1, n.Created)
+			}
+			entry.CreatedAt = t
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// DecodeNDJSON はNDJSON（1行に1ノートのJSONオブジェクト）のノートを変換します
+// ExportNotesのNDJSON形式のtitle、content、tags、created_atを読み取ります
+func DecodeNDJSON(data []byte) ([]Entry, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64<<10), maxNDJSONLineBytes)
+
+	var entries []Entry
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record noteRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, Entry{
+			Title:     record.Title,
+			Content:   record.Content,
+			Tags:      record.Tags,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", line+1, err)
+	}
+	return entries, nil
+}
+
+// DecodeCSV はヘッダー行付きのCSVのノートを変換します
+// title列とcontent列は必須で、tags列（改行区切り）とcreated_at列は省略できます
+func DecodeCSV(data []byte) ([]Entry, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"title", "content"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header must contain a %q column", name)
+		}
+	}
+
+	var entries []Entry
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry := Entry{
+			Title:   record[columns["title"]],
+			Content: record[columns["content"]],
+		}
+		if i, ok := columns["tags"]; ok && record[i] != "" {
+			entry.Tags = strings.Split(record[i], "\n")
+		}
+		if i, ok := columns["created_at"]; ok && record[i] != "" {
+			if entry.CreatedAt, err = parseTime(record[i]); err != nil {
+				line, _ := r.FieldPos(i)
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
@@ -0,0 +1,246 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"go_test/internal/domain"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// maxSlugLength はMarkdownファイル名に使うタイトル部分の最大文字数（rune数）です
+const maxSlugLength = 50
+
+// csvHeader はCSV形式のヘッダー行です
+var csvHeader = []string{"id", "title", "content", "tags", "notebook_id", "pinned", "archived", "version", "created_at", "updated_at"}
+
+// Writer はノートを1件ずつアーカイブに書き込みます
+type Writer interface {
+	// WriteNote はノートをアーカイブに書き込みます
+	WriteNote(note *domain.Note) error
+	// Close はアーカイブの末尾を書き込みます。下位のio.Writerは閉じません
+	Close() error
+}
+
+// NewWriter は指定した形式でwに書き込むWriterを作成します
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case FormatMarkdownZip:
+		return &zipWriter{zw: zip.NewWriter(w)}, nil
+	case FormatMarkdownTar:
+		gz := gzip.NewWriter(w)
+		return &tarWriter{gz: gz, tw: tar.NewWriter(gz)}, nil
+	case FormatNDJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return &ndjsonWriter{enc: enc}, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvHeader); err != nil {
+			return nil, err
+		}
+		return &csvWriter{cw: cw}, nil
+	default:
+		return nil, fmt.Errorf("unknown archive format %q", format)
+	}
+}
+
+// noteRecord はNDJSON形式の1行に書くノートです
+type noteRecord struct {
+	ID         int64     `json:"id"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	Tags       []string  `json:"tags"`
+	NotebookID int64     `json:"notebook_id"`
+	Pinned     bool      `json:"pinned"`
+	Archived   bool      `json:"archived"`
+	Version    int64     `json:"version"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// zipWriter はMarkdownファイルをzipアーカイブに書き込みます
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (z *zipWriter) WriteNote(note *domain.Note) error {
+	f, err := z.zw.CreateHeader(&zip.FileHeader{
+		Name:     MarkdownFilename(note),
+		Method:   zip.Deflate,
+		Modified: note.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(MarshalMarkdown(note))
+	return err
+}
+
+func (z *zipWriter) Close() error {
+	return z.zw.Close()
+}
+
+// tarWriter はMarkdownファイルをgzip圧縮のtarアーカイブに書き込みます
+type tarWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func (t *tarWriter) WriteNote(note *domain.Note) error {
+	body := MarshalMarkdown(note)
+	if err := t.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     MarkdownFilename(note),
+		Mode:     0o644,
+		Size:     int64(len(body)),
+		ModTime:  note.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	_, err := t.tw.Write(body)
+	return err
+}
+
+func (t *tarWriter) Close() error {
+	if err := t.tw.Close(); err != nil {
+		return err
+	}
+	return t.gz.Close()
+}
+
+// ndjsonWriter はノートを1行に1件ずつJSONで書き込みます
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) WriteNote(note *domain.Note) error {
+	return n.enc.Encode(noteRecord{
+		ID:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		Tags:       nonNilTags(note.Tags),
+		NotebookID: note.NotebookID,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
+		Version:    note.Version,
+		CreatedAt:  note.CreatedAt,
+		UpdatedAt:  note.UpdatedAt,
+	})
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+// csvWriter はノートをCSVの1行ずつ書き込みます
+// タグは改行区切りで1つのセルに書きます（タグは制御文字を含まないため区切り文字と衝突しません）
+type csvWriter struct {
+	cw *csv.Writer
+}
+
+func (c *csvWriter) WriteNote(note *domain.Note) error {
+	return c.cw.Write([]string{
+		strconv.FormatInt(note.ID, 10),
+		note.Title,
+		note.Content,
+		strings.Join(note.Tags, "\n"),
+		strconv.FormatInt(note.NotebookID, 10),
+		strconv.FormatBool(note.Pinned),
+		strconv.FormatBool(note.Archived),
+		strconv.FormatInt(note.Version, 10),
+		note.CreatedAt.UTC().Format(time.RFC3339),
+		note.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.cw.Flush()
+	return c.cw.Error()
+}
+
+// MarkdownFilename はノートを書き出すMarkdownファイルの名前（"<ID>-<タイトルのスラッグ>.md"）を返します
+func MarkdownFilename(note *domain.Note) string {
+	slug := slugify(note.Title)
+	if slug == "" {
+		return fmt.Sprintf("%d.md", note.ID)
+	}
+	return fmt.Sprintf("%d-%s.md", note.ID, slug)
+}
+
+// slugify はタイトルの文字と数字以外をハイフンに置き換えて小文字にします
+// 日本語などの文字はそのまま残します
+func slugify(title string) string {
+	var b strings.Builder
+	n := 0
+	pendingHyphen := false
+	for _, r := range strings.ToLower(title) {
+		if n >= maxSlugLength {
+			break
+		}
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteRune('-')
+			n++
+			pendingHyphen = false
+		}
+		b.WriteRune(r)
+		n++
+	}
+	return b.String()
+}
+
+// MarshalMarkdown はノートをYAMLフロントマター付きのMarkdownに変換します
+// 文字列はJSONの文字列リテラルで書きます（YAMLのダブルクォート文字列としても有効です）
+func MarshalMarkdown(note *domain.Note) []byte {
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %d\n", note.ID)
+	fmt.Fprintf(&b, "title: %s\n", quote(note.Title))
+	fmt.Fprintf(&b, "created: %s\n", note.CreatedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "updated: %s\n", note.UpdatedAt.UTC().Format(time.RFC3339))
+	tags := make([]string, len(note.Tags))
+	for i, tag := range note.Tags {
+		tags[i] = quote(tag)
+	}
+	fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(tags, ", "))
+	if note.NotebookID != 0 {
+		fmt.Fprintf(&b, "notebook_id: %d\n", note.NotebookID)
+	}
+	fmt.Fprintf(&b, "pinned: %t\n", note.Pinned)
+	fmt.Fprintf(&b, "archived: %t\n", note.Archived)
+	fmt.Fprintf(&b, "version: %d\n", note.Version)
+	b.WriteString("---\n\n")
+	// 本文はインポート時に元の内容へ戻せるようにそのまま書きます
+	b.WriteString(note.Content)
+	return b.Bytes()
+}
+
+// quote は文字列をHTMLをエスケープしないJSONの文字列リテラルにします
+func quote(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	// 文字列のエンコードは失敗しません
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// nonNilTags はタグがない場合にJSONでnullではなく空の配列を書くために空のスライスを返します
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
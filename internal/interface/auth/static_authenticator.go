@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// staticAuthenticator は設定で与えられたトークンと主体の対応でAuthenticatorを実装します
+type staticAuthenticator struct {
+	// principals はトークンのSHA256をキーとした主体の一覧です
+	principals map[[sha256.Size]byte]*domain.Principal
+}
+
+// NewStaticAuthenticator はトークンから主体IDへの対応で認証するAuthenticatorを作成します
+func NewStaticAuthenticator(tokens map[string]string) usecase.Authenticator {
+	principals := make(map[[sha256.Size]byte]*domain.Principal, len(tokens))
+	for token, id := range tokens {
+		principals[sha256.Sum256([]byte(token))] = &domain.Principal{ID: id}
+	}
+	return &staticAuthenticator{principals: principals}
+}
+
+// Authenticate はトークンに対応する主体を返します
+// トークンはハッシュ値で照合し、照合にかかる時間からトークンを推測されないようにします
+func (a *staticAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	sum := sha256.Sum256([]byte(token))
+	for key, p := range a.principals {
+		if subtle.ConstantTimeCompare(key[:], sum[:]) == 1 {
+			return p, nil
+		}
+	}
+	return nil, domain.ErrUnauthenticated
+}
+
+// ParseTokens は"トークン=主体ID"をカンマで区切った設定値を解析します
+func ParseTokens(value string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		token, id, ok := strings.Cut(entry, "=")
+		token, id = strings.TrimSpace(token), strings.TrimSpace(id)
+		if !ok || token == "" || id == "" {
+			return nil, fmt.Errorf("invalid token entry %q: expected token=principal", entry)
+		}
+		if utf8.RuneCountInString(id) > domain.MaxPrincipalIDLength {
+			return nil, fmt.Errorf("principal %q must be at most %d characters", id, domain.MaxPrincipalIDLength)
+		}
+		if _, dup := tokens[token]; dup {
+			return nil, fmt.Errorf("duplicate token for principal %q", id)
+		}
+		tokens[token] = id
+	}
+	return tokens, nil
+}
@@ -0,0 +1,203 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// tmpDir は書き込み中の内容を置くディレクトリ名です
+const tmpDir = "tmp"
+
+// localStore はローカルファイルシステムにBlobStoreを実装します
+// 内容は<root>/<ダイジェストの先頭2文字>/<ダイジェスト>に保存します
+type localStore struct {
+	root string
+
+	// mu は内容の配置・保存日時の更新とスイーパーによる削除を排他的にします
+	mu sync.Mutex
+}
+
+// NewLocalStore はrootディレクトリに内容を保存するBlobStoreを作成します
+func NewLocalStore(root string) (usecase.BlobStore, error) {
+	if err := os.MkdirAll(filepath.Join(root, tmpDir), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &localStore{root: root}, nil
+}
+
+// path はダイジェストに対応するファイルのパスを返します
+func (s *localStore) path(digest string) (string, error) {
+	if !validDigest(digest) {
+		return "", fmt.Errorf("invalid blob digest %q", digest)
+	}
+	return filepath.Join(s.root, digest[:2], digest), nil
+}
+
+// Put は一時ファイルに書き込みながらハッシュを計算し、完了後にダイジェストの位置へ移動します
+// 同じ内容が既に存在する場合は一時ファイルを破棄します
+func (s *localStore) Put(ctx context.Context, r io.Reader, maxSize int64) (string, int64, error) {
+	tmp, err := os.CreateTemp(filepath.Join(s.root, tmpDir), "upload-*")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), io.LimitReader(&contextReader{ctx: ctx, r: r}, maxSize+1))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to write blob: %w", err)
+	}
+	if size > maxSize {
+		return "", 0, fmt.Errorf("attachment exceeds %d bytes: %w", maxSize, domain.ErrAttachmentTooLarge)
+	}
+	if err := tmp.Sync(); err != nil {
+		return "", 0, fmt.Errorf("failed to sync blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", 0, fmt.Errorf("failed to close blob: %w", err)
+	}
+
+	digest := hex.EncodeToString(h.Sum(nil))
+	dst, err := s.path(digest)
+	if err != nil {
+		return "", 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return "", 0, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	if _, err := os.Stat(dst); err == nil {
+		// 既存の内容の保存日時を更新し、スイーパーの猶予期間中に削除されないようにします
+		now := time.Now()
+		if err := os.Chtimes(dst, now, now); err != nil {
+			return "", 0, fmt.Errorf("failed to touch blob: %w", err)
+		}
+		return digest, size, nil
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return "", 0, fmt.Errorf("failed to store blob: %w", err)
+	}
+	return digest, size, nil
+}
+
+// Open は内容を読み取り用に開きます
+func (s *localStore) Open(ctx context.Context, digest string) (io.ReadCloser, error) {
+	p, err := s.path(digest)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("blob %s: %w", digest, domain.ErrAttachmentNotFound)
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return f, nil
+}
+
+// DeleteIfStale はPutと排他的に保存日時を確認し直し、cutoffより前で参照されていない場合に内容を削除します
+func (s *localStore) DeleteIfStale(ctx context.Context, digest string, cutoff time.Time, unreferenced func() (bool, error)) (bool, error) {
+	p, err := s.path(digest)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat blob: %w", err)
+	}
+	if !info.ModTime().Before(cutoff) {
+		return false, nil
+	}
+
+	ok, err := unreferenced()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if err := os.Remove(p); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return true, nil
+}
+
+// Walk は保存されている内容を列挙します。書き込み中の一時ファイルは対象外です
+func (s *localStore) Walk(ctx context.Context, fn func(digest string, storedAt time.Time) error) error {
+	return filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == tmpDir && filepath.Dir(p) == filepath.Clean(s.root) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !validDigest(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		return fn(d.Name(), info.ModTime())
+	})
+}
+
+// validDigest はdigestが小文字16進数のSHA256ダイジェストかどうかを判定します
+func validDigest(digest string) bool {
+	if len(digest) != sha256.Size*2 {
+		return false
+	}
+	for _, c := range digest {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// contextReader はctxがキャンセルされると読み取りを中断するio.Readerです
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
@@ -0,0 +1,47 @@
+package blob
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeleteIfStale(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreferenced := func() (bool, error) { return true, nil }
+
+	digest, _, err := store.Put(ctx, strings.NewReader("content"), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 一覧の取得後に同じ内容が保存し直された場合は、保存日時がcutoff以降になるため削除しません
+	cutoff := time.Now().Add(-time.Hour)
+	if deleted, err := store.DeleteIfStale(ctx, digest, cutoff, unreferenced); err != nil || deleted {
+		t.Fatalf("DeleteIfStale() with a fresh blob = %v, %v; want false, nil", deleted, err)
+	}
+
+	// 削除の直前に参照が見つかった場合は削除しません
+	cutoff = time.Now().Add(time.Hour)
+	referenced := func() (bool, error) { return false, nil }
+	if deleted, err := store.DeleteIfStale(ctx, digest, cutoff, referenced); err != nil || deleted {
+		t.Fatalf("DeleteIfStale() with a referenced blob = %v, %v; want false, nil", deleted, err)
+	}
+
+	if deleted, err := store.DeleteIfStale(ctx, digest, cutoff, unreferenced); err != nil || !deleted {
+		t.Fatalf("DeleteIfStale() with a stale blob = %v, %v; want true, nil", deleted, err)
+	}
+	if _, err := store.Open(ctx, digest); err == nil {
+		t.Error("Open() after delete succeeded, want an error")
+	}
+
+	// 既に削除されている場合は何もしません
+	if deleted, err := store.DeleteIfStale(ctx, digest, cutoff, unreferenced); err != nil || deleted {
+		t.Errorf("DeleteIfStale() with a missing blob = %v, %v; want false, nil", deleted, err)
+	}
+}
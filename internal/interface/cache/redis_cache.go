@@ -10,6 +10,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// cacheTTL はキャッシュした値の有効期限です
+const cacheTTL = 24 * time.Hour
+
 // redisCache はCacheインターフェースを実装します
 type redisCache struct {
 	client *redis.Client
@@ -27,7 +30,7 @@ func (c *redisCache) Set(ctx context.Context, key string, value interface{}) err
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
 
-	err = c.client.Set(ctx, key, jsonValue, 24*time.Hour).Err()
+	err = c.client.Set(ctx, key, jsonValue, cacheTTL).Err()
 	if err != nil {
 		return fmt.Errorf("failed to set cache: %w", err)
 	}
@@ -48,6 +51,48 @@ func (c *redisCache) Get(ctx context.Context, key string) (string, error) {
 	return val, nil
 }
 
+// GetMulti はMGETで複数のキーの値を1回の往復で取得します
+func (c *redisCache) GetMulti(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	vals, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache: %w", err)
+	}
+
+	result := make([]string, len(keys))
+	for i, v := range vals {
+		// 存在しないキーはnilとして返されます
+		if s, ok := v.(string); ok {
+			result[i] = s
+		}
+	}
+	return result, nil
+}
+
+// SetMulti はパイプラインで複数の値を1回の往復で設定します
+func (c *redisCache) SetMulti(ctx context.Context, values map[string]interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	pipe := c.client.Pipeline()
+	for key, value := range values {
+		jsonValue, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal value: %w", err)
+		}
+		pipe.Set(ctx, key, jsonValue, cacheTTL)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set cache: %w", err)
+	}
+	return nil
+}
+
 // Delete はRedisキャッシュから値を削除します
 func (c *redisCache) Delete(ctx context.Context, key string) error {
 	err := c.client.Del(ctx, key).Err()
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go_test/internal/usecase"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisIdempotencyStore はIdempotencyStoreインターフェースを実装します
+type redisIdempotencyStore struct {
+	client *redis.Client
+}
+
+// NewRedisIdempotencyStore は新しいRedis冪等キーストアを作成します
+func NewRedisIdempotencyStore(client *redis.Client) usecase.IdempotencyStore {
+	return &redisIdempotencyStore{client: client}
+}
+
+// Reserve はSET NXでキーを確保します
+func (s *redisIdempotencyStore) Reserve(ctx context.Context, key string, record *usecase.IdempotencyRecord, ttl time.Duration) (bool, *usecase.IdempotencyRecord, error) {
+	jsonValue, err := json.Marshal(record)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	ok, err := s.client.SetNX(ctx, key, jsonValue, ttl).Result()
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if ok {
+		return true, nil, nil
+	}
+
+	existing, err := s.Get(ctx, key)
+	if err != nil {
+		return false, nil, err
+	}
+	return false, existing, nil
+}
+
+// Get はRedisから冪等キーのレコードを取得します
+func (s *redisIdempotencyStore) Get(ctx context.Context, key string) (*usecase.IdempotencyRecord, error) {
+	val, err := s.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get idempotency record: %w", err)
+	}
+
+	var record usecase.IdempotencyRecord
+	if err := json.Unmarshal(val, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal idempotency record: %w", err)
+	}
+
+	return &record, nil
+}
+
+// renewScript はKEYS[1]がARGV[1]の処理中レコードである場合に有効期限をARGV[2]ミリ秒に延長します
+var renewScript = redis.NewScript(`
+local value = redis.call('GET', KEYS[1])
+if not value then
+	return 0
+end
+local record = cjson.decode(value)
+if record.owner ~= ARGV[1] or record.completed then
+	return 0
+end
+return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+`)
+
+// completeScript はKEYS[1]がARGV[1]の処理中レコードである場合にARGV[2]の値をARGV[3]ミリ秒のTTL付きで保存します
+var completeScript = redis.NewScript(`
+local value = redis.call('GET', KEYS[1])
+if not value then
+	return 0
+end
+local record = cjson.decode(value)
+if record.owner ~= ARGV[1] or record.completed then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// releaseScript はKEYS[1]がARGV[1]の処理中レコードである場合に削除します
+var releaseScript = redis.NewScript(`
+local value = redis.call('GET', KEYS[1])
+if not value then
+	return 0
+end
+local record = cjson.decode(value)
+if record.owner ~= ARGV[1] or record.completed then
+	return 0
+end
+return redis.call('DEL', KEYS[1])
+`)
+
+// Renew は処理中レコードの所有者を確認して有効期限を延長します
+func (s *redisIdempotencyStore) Renew(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	renewed, err := renewScript.Run(ctx, s.client, []string{key}, owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew idempotency key: %w", err)
+	}
+	return renewed == 1, nil
+}
+
+// Complete は処理中レコードの所有者を確認して、完了したレコードをTTL付きで保存します
+func (s *redisIdempotencyStore) Complete(ctx context.Context, key, owner string, record *usecase.IdempotencyRecord, ttl time.Duration) error {
+	jsonValue, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	completed, err := completeScript.Run(ctx, s.client, []string{key}, owner, jsonValue, ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency record: %w", err)
+	}
+	if completed == 0 {
+		return fmt.Errorf("complete %s: %w", key, usecase.ErrIdempotencyReservationLost)
+	}
+
+	return nil
+}
+
+// Release は処理中レコードの所有者を確認してRedisから冪等キーを削除します
+func (s *redisIdempotencyStore) Release(ctx context.Context, key, owner string) error {
+	if err := releaseScript.Run(ctx, s.client, []string{key}, owner).Err(); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
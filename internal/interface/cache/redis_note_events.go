@@ -0,0 +1,395 @@
+package cache
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go_test/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// noteEventStreamKey はノートの変更イベントを保存するRedis Streamのキーです
+	noteEventStreamKey = "note_events"
+	// noteEventReadBlock はXREADで新しいイベントを待つ最大時間です
+	noteEventReadBlock = 5 * time.Second
+	// noteEventReadCount はXREAD・XRANGEで一度に読み取る件数です
+	noteEventReadCount = 100
+	// noteEventBufferSize は購読者ごとに受信待ちにできるイベントの件数です
+	noteEventBufferSize = 256
+	// noteEventRetryInterval はストリームの読み取りに失敗した場合に再試行するまでの時間です
+	noteEventRetryInterval = time.Second
+	// noteEventClaimIdle はコンシューマーグループで処理が終わっていないイベントを他のコンシューマーが引き取るまでの時間です
+	noteEventClaimIdle = 30 * time.Second
+)
+
+// RedisNoteEventBus はRedis Streamを使ってNoteEventPublisher・NoteEventSubscriber・NoteEventConsumerを実装します
+// 各レプリカはRunで1本のXREADによりストリームを読み取り、プロセス内の購読者に配信します
+type RedisNoteEventBus struct {
+	client *redis.Client
+	maxLen int64
+
+	mu          sync.Mutex
+	subscribers map[chan *domain.NoteEvent]struct{}
+}
+
+// NewRedisNoteEventBus は新しいRedisイベントバスを作成します
+// ストリームには概ねmaxLen件のイベントを保持し、それより古いイベントからは再開できません
+func NewRedisNoteEventBus(client *redis.Client, maxLen int64) *RedisNoteEventBus {
+	return &RedisNoteEventBus{
+		client:      client,
+		maxLen:      maxLen,
+		subscribers: make(map[chan *domain.NoteEvent]struct{}),
+	}
+}
+
+// Publish はXADDでイベントをストリームに追加し、割り当てられたIDをeventに設定します
+func (b *RedisNoteEventBus) Publish(ctx context.Context, event *domain.NoteEvent) error {
+	id, err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: noteEventStreamKey,
+		MaxLen: b.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"type":        string(event.Type),
+			"note_id":     event.NoteID,
+			"version":     event.Version,
+			"occurred_at": event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to publish note event: %w", err)
+	}
+	event.ID = id
+	return nil
+}
+
+// Subscribe はafterIDより後のイベントをfnに渡します
+// 先に購読者として登録してから保存済みのイベントを読み取り、重複したイベントはIDで読み飛ばします
+func (b *RedisNoteEventBus) Subscribe(ctx context.Context, afterID string, fn func(*domain.NoteEvent) error) error {
+	ch := make(chan *domain.NoteEvent, noteEventBufferSize)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+	defer b.unsubscribe(ch)
+
+	lastID := afterID
+	if afterID != "" {
+		if _, _, err := parseStreamID(afterID); err != nil {
+			return &domain.ValidationError{Violations: []domain.FieldViolation{{Field: "after_event_id", Description: "is not a valid event ID"}}}
+		}
+
+		msgs, err := b.readResumeHead(ctx, afterID)
+		for {
+			if err != nil {
+				return err
+			}
+			for _, msg := range msgs {
+				event, err := parseNoteEvent(msg)
+				if err != nil {
+					log.Printf("Warning: skipping malformed note event %s: %v", msg.ID, err)
+					lastID = msg.ID
+					continue
+				}
+				if err := fn(event); err != nil {
+					return err
+				}
+				lastID = event.ID
+			}
+			if len(msgs) < noteEventReadCount {
+				break
+			}
+			msgs, err = b.client.XRangeN(ctx, noteEventStreamKey, "("+lastID, "+", noteEventReadCount).Result()
+			if err != nil {
+				err = fmt.Errorf("failed to read note events: %w", err)
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-ch:
+			if !ok {
+				return domain.ErrWatchLagging
+			}
+			if lastID != "" && compareStreamIDs(event.ID, lastID) <= 0 {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+			lastID = event.ID
+		}
+	}
+}
+
+// readResumeHead はafterIDより後のイベントを最大noteEventReadCount件読み取ります
+// afterIDより後のイベントがトリムで削除されている場合はdomain.ErrWatchCursorExpiredを返します
+// 削除済みのIDの確認と読み取りの間にトリムされないよう、MULTIで不可分に実行します
+func (b *RedisNoteEventBus) readResumeHead(ctx context.Context, afterID string) ([]redis.XMessage, error) {
+	var info *redis.XInfoStreamCmd
+	var msgs *redis.XMessageSliceCmd
+	// コマンドごとのエラーは以下で確認します
+	_, _ = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		info = pipe.XInfoStream(ctx, noteEventStreamKey)
+		msgs = pipe.XRangeN(ctx, noteEventStreamKey, "("+afterID, "+", noteEventReadCount)
+		return nil
+	})
+
+	if err := info.Err(); err != nil {
+		// ストリームがまだ作成されていない場合は削除されたイベントもありません
+		if !strings.HasPrefix(err.Error(), "ERR no such key") {
+			return nil, fmt.Errorf("failed to inspect note events: %w", err)
+		}
+	} else if trimmedAfter(info.Val(), afterID) {
+		return nil, fmt.Errorf("resume after %s: %w", afterID, domain.ErrWatchCursorExpired)
+	}
+
+	result, err := msgs.Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read note events: %w", err)
+	}
+	return result, nil
+}
+
+// trimmedAfter はafterIDより後のイベントがストリームから削除されているかを判定します
+func trimmedAfter(info *redis.XInfoStream, afterID string) bool {
+	if info.MaxDeletedEntryID != "" {
+		return compareStreamIDs(afterID, info.MaxDeletedEntryID) < 0
+	}
+	// Redis 7より前は削除済みの最大IDを取得できないため、最も古いイベントより前からの再開を削除済みとみなします
+	return info.Length > 0 && compareStreamIDs(afterID, info.FirstEntry.ID) < 0
+}
+
+// unsubscribe は購読者の登録を解除します
+func (b *RedisNoteEventBus) unsubscribe(ch chan *domain.NoteEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers, ch)
+}
+
+// Run はctxがキャンセルされるまでストリームを読み取り、購読者に配信します
+func (b *RedisNoteEventBus) Run(ctx context.Context) {
+	lastID := ""
+	for ctx.Err() == nil {
+		if lastID == "" {
+			// 起動時点の最新のイベントから読み始めます（"$"を使い続けると読み取りの合間のイベントを取りこぼします）
+			id, err := b.latestID(ctx)
+			if err != nil {
+				b.retryAfterError(ctx, err)
+				continue
+			}
+			lastID = id
+		}
+
+		streams, err := b.client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{noteEventStreamKey, lastID},
+			Count:   noteEventReadCount,
+			Block:   noteEventReadBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			b.retryAfterError(ctx, err)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				lastID = msg.ID
+				event, err := parseNoteEvent(msg)
+				if err != nil {
+					log.Printf("Warning: skipping malformed note event %s: %v", msg.ID, err)
+					continue
+				}
+				b.broadcast(event)
+			}
+		}
+	}
+}
+
+// Consume はコンシューマーグループgroupのconsumerとしてイベントを読み取り、fnが成功したイベントをXACKします
+// fnが失敗したイベントはnoteEventClaimIdleの経過後にXAUTOCLAIMで（他のレプリカを含む）コンシューマーが引き取ります
+func (b *RedisNoteEventBus) Consume(ctx context.Context, group, consumer string, fn func(*domain.NoteEvent) error) error {
+	created := false
+	for ctx.Err() == nil {
+		if !created {
+			// グループが既に存在する場合は続きから読み取ります。新しく作成する場合は作成以降のイベントから読み取ります
+			err := b.client.XGroupCreateMkStream(ctx, noteEventStreamKey, group, "$").Err()
+			if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+				b.retryAfterError(ctx, err)
+				continue
+			}
+			created = true
+		}
+
+		claimed, _, err := b.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   noteEventStreamKey,
+			Group:    group,
+			Consumer: consumer,
+			MinIdle:  noteEventClaimIdle,
+			Start:    "0-0",
+			Count:    noteEventReadCount,
+		}).Result()
+		if err != nil {
+			b.retryAfterError(ctx, err)
+			continue
+		}
+		for _, msg := range claimed {
+			b.handle(ctx, group, msg, fn)
+		}
+
+		streams, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  []string{noteEventStreamKey, ">"},
+			Count:    noteEventReadCount,
+			Block:    noteEventReadBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			b.retryAfterError(ctx, err)
+			continue
+		}
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				b.handle(ctx, group, msg, fn)
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// handle はコンシューマーグループで読み取った1件のイベントをfnに渡し、成功した場合はXACKします
+// 読み取れないイベントは何度処理しても失敗するため、ログに記録してXACKします
+func (b *RedisNoteEventBus) handle(ctx context.Context, group string, msg redis.XMessage, fn func(*domain.NoteEvent) error) {
+	event, err := parseNoteEvent(msg)
+	if err != nil {
+		log.Printf("Warning: skipping malformed note event %s: %v", msg.ID, err)
+	} else if err := fn(event); err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Warning: failed to handle note event %s in group %s: %v", msg.ID, group, err)
+		}
+		return
+	}
+
+	if err := b.client.XAck(ctx, noteEventStreamKey, group, msg.ID).Err(); err != nil && ctx.Err() == nil {
+		log.Printf("Warning: failed to acknowledge note event %s in group %s: %v", msg.ID, group, err)
+	}
+}
+
+// retryAfterError はエラーをログに記録し、再試行まで待機します
+func (b *RedisNoteEventBus) retryAfterError(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	log.Printf("Warning: failed to read note events: %v", err)
+	select {
+	case <-ctx.Done():
+	case <-time.After(noteEventRetryInterval):
+	}
+}
+
+// latestID はストリームの最新のイベントIDを返します。空の場合は"0-0"を返します
+func (b *RedisNoteEventBus) latestID(ctx context.Context) (string, error) {
+	msgs, err := b.client.XRevRangeN(ctx, noteEventStreamKey, "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "0-0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// broadcast はイベントをすべての購読者に配信します
+// 受信待ちのイベントが上限に達した購読者は、配信全体を止めないようチャネルを閉じて登録を解除します
+func (b *RedisNoteEventBus) broadcast(event *domain.NoteEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			close(ch)
+			delete(b.subscribers, ch)
+		}
+	}
+}
+
+// parseNoteEvent はストリームのエントリーをイベントに変換します
+func parseNoteEvent(msg redis.XMessage) (*domain.NoteEvent, error) {
+	field := func(name string) string {
+		v, _ := msg.Values[name].(string)
+		return v
+	}
+
+	noteID, err := strconv.ParseInt(field("note_id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid note_id: %w", err)
+	}
+	version, err := strconv.ParseInt(field("version"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+	occurredAt, err := time.Parse(time.RFC3339Nano, field("occurred_at"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid occurred_at: %w", err)
+	}
+
+	return &domain.NoteEvent{
+		ID:         msg.ID,
+		Type:       domain.NoteEventType(field("type")),
+		NoteID:     noteID,
+		Version:    version,
+		OccurredAt: occurredAt,
+	}, nil
+}
+
+// compareStreamIDs はRedis StreamのID（"ミリ秒-連番"）を比較し、aが小さい場合は負、等しい場合は0、大きい場合は正を返します
+func compareStreamIDs(a, b string) int {
+	am, as := splitStreamID(a)
+	bm, bs := splitStreamID(b)
+	if am != bm {
+		return cmp.Compare(am, bm)
+	}
+	return cmp.Compare(as, bs)
+}
+
+// parseStreamID は"ミリ秒-連番"形式のIDをミリ秒と連番に分割します
+func parseStreamID(id string) (uint64, uint64, error) {
+	msPart, seqPart, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid stream ID %q", id)
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream ID %q: %w", id, err)
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream ID %q: %w", id, err)
+	}
+	return ms, seq, nil
+}
+
+// splitStreamID はIDをミリ秒と連番に分割します。連番が省略されている場合は0とします
+func splitStreamID(id string) (uint64, uint64) {
+	msPart, seqPart, _ := strings.Cut(id, "-")
+	ms, _ := strconv.ParseUint(msPart, 10, 64)
+	seq, _ := strconv.ParseUint(seqPart, 10, 64)
+	return ms, seq
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestTrimmedAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    *redis.XInfoStream
+		afterID string
+		want    bool
+	}{
+		{
+			name:    "nothing deleted",
+			info:    &redis.XInfoStream{Length: 3, MaxDeletedEntryID: "0-0", FirstEntry: redis.XMessage{ID: "100-0"}},
+			afterID: "0-0",
+			want:    false,
+		},
+		{
+			name:    "resume point after the deleted entries",
+			info:    &redis.XInfoStream{Length: 3, MaxDeletedEntryID: "99-5", FirstEntry: redis.XMessage{ID: "100-0"}},
+			afterID: "99-5",
+			want:    false,
+		},
+		{
+			name:    "entries after the resume point were trimmed",
+			info:    &redis.XInfoStream{Length: 3, MaxDeletedEntryID: "99-5", FirstEntry: redis.XMessage{ID: "100-0"}},
+			afterID: "99-4",
+			want:    true,
+		},
+		{
+			name:    "sequence numbers compare numerically",
+			info:    &redis.XInfoStream{Length: 3, MaxDeletedEntryID: "99-10", FirstEntry: redis.XMessage{ID: "100-0"}},
+			afterID: "99-9",
+			want:    true,
+		},
+		{
+			// Redis 7より前は最も古いイベントと比較します
+			name:    "before the first entry without max deleted ID",
+			info:    &redis.XInfoStream{Length: 3, FirstEntry: redis.XMessage{ID: "100-0"}},
+			afterID: "99-0",
+			want:    true,
+		},
+		{
+			name:    "empty stream without max deleted ID",
+			info:    &redis.XInfoStream{},
+			afterID: "99-0",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trimmedAfter(tt.info, tt.afterID); got != tt.want {
+				t.Errorf("trimmedAfter(%q) = %v, want %v", tt.afterID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStreamID(t *testing.T) {
+	tests := []struct {
+		id      string
+		ms, seq uint64
+		wantErr bool
+	}{
+		{id: "1700000000000-3", ms: 1700000000000, seq: 3},
+		{id: "0-0"},
+		{id: "1700000000000", wantErr: true},
+		{id: "-1", wantErr: true},
+		{id: "1-", wantErr: true},
+		{id: "99999999999999999999-0", wantErr: true},
+		{id: "1-99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		ms, seq, err := parseStreamID(tt.id)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseStreamID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if ms != tt.ms || seq != tt.seq {
+			t.Errorf("parseStreamID(%q) = %d, %d; want %d, %d", tt.id, ms, seq, tt.ms, tt.seq)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// downloadChunkSize はDownloadAttachmentで1メッセージに載せる最大バイト数です
+const downloadChunkSize = 64 << 10
+
+// UploadAttachment はUploadAttachment RPCメソッドを実装します
+func (s *server) UploadAttachment(stream grpc.ClientStreamingServer[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "metadata is required")
+		}
+		return err
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry metadata")
+	}
+
+	r := &uploadReader{stream: stream}
+	attachment, err := s.attachmentUsecase.UploadAttachment(stream.Context(), meta.NoteId, meta.Filename, r)
+	if r.err != nil {
+		// 受信時のエラー（検証エラーやキャンセル）はそのまま返します
+		return r.err
+	}
+	if err != nil {
+		return toStatusError(err, "failed to upload attachment")
+	}
+
+	return stream.SendAndClose(&v1.UploadAttachmentResponse{Attachment: toProtoAttachment(attachment)})
+}
+
+// uploadReader はUploadAttachmentのストリームで受信したチャンクを順に読み取るio.Readerです
+type uploadReader struct {
+	stream grpc.ClientStreamingServer[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	buf    []byte
+	err    error
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		req, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if req.GetMetadata() != nil {
+			r.err = status.Error(codes.InvalidArgument, "metadata must be sent only in the first message")
+			return 0, r.err
+		}
+		r.buf = req.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// DownloadAttachment はDownloadAttachment RPCメソッドを実装します
+func (s *server) DownloadAttachment(req *v1.DownloadAttachmentRequest, stream grpc.ServerStreamingServer[v1.DownloadAttachmentResponse]) error {
+	attachment, rc, err := s.attachmentUsecase.DownloadAttachment(stream.Context(), req.NoteId, req.AttachmentId)
+	if err != nil {
+		return toStatusError(err, "failed to download attachment")
+	}
+	defer rc.Close()
+
+	if err := stream.Send(&v1.DownloadAttachmentResponse{
+		Data: &v1.DownloadAttachmentResponse_Metadata{Metadata: toProtoAttachment(attachment)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&v1.DownloadAttachmentResponse{
+				Data: &v1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+}
+
+// ListAttachments はListAttachments RPCメソッドを実装します
+func (s *server) ListAttachments(ctx context.Context, req *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error) {
+	attachments, err := s.attachmentUsecase.ListAttachments(ctx, req.NoteId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list attachments")
+	}
+
+	res := &v1.ListAttachmentsResponse{Attachments: make([]*v1.Attachment, 0, len(attachments))}
+	for _, a := range attachments {
+		res.Attachments = append(res.Attachments, toProtoAttachment(a))
+	}
+	return res, nil
+}
+
+// DeleteAttachment はDeleteAttachment RPCメソッドを実装します
+func (s *server) DeleteAttachment(ctx context.Context, req *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error) {
+	if err := s.attachmentUsecase.DeleteAttachment(ctx, req.NoteId, req.AttachmentId); err != nil {
+		return nil, toStatusError(err, "failed to delete attachment")
+	}
+
+	return &v1.DeleteAttachmentResponse{}, nil
+}
+
+// toProtoAttachment はドメインの添付ファイルをprotoメッセージに変換します
+func toProtoAttachment(a *domain.Attachment) *v1.Attachment {
+	return &v1.Attachment{
+		Id:          a.ID,
+		NoteId:      a.NoteID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.SHA256,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
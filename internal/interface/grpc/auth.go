@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"go_test/internal/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authUnaryInterceptor は"authorization: Bearer <token>"メタデータから主体を特定してコンテキストに格納します
+// メタデータがない場合は認証されていないリクエストとして扱い、主体が必要かどうかはユースケースが判断します
+func authUnaryInterceptor(authenticator usecase.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamInterceptor はストリームRPCでauthUnaryInterceptorと同じ認証を行います
+func authStreamInterceptor(authenticator usecase.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedServerStream は主体を格納したコンテキストを返すgrpc.ServerStreamです
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context は主体を格納したコンテキストを返します
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate はメタデータのトークンを検証し、主体を格納したコンテキストを返します
+func authenticate(ctx context.Context, authenticator usecase.Authenticator) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	principal, err := authenticator.Authenticate(ctx, strings.TrimSpace(token))
+	if err != nil {
+		return nil, toStatusError(err, "failed to authenticate")
+	}
+	return usecase.WithPrincipal(ctx, principal), nil
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"fmt"
+	"io"
+
+	"go_test/internal/usecase"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBulkCreateBufferedBytes はALL_OR_NOTHINGモードで作成まで保持するノートの合計バイト数の上限です
+const maxBulkCreateBufferedBytes = 32 << 20
+
+// BulkCreateNotes はBulkCreateNotes RPCメソッドを実装します
+// PARTIALモードではusecase.BulkCreateBatchSize件受信するたびに作成し、ALL_OR_NOTHINGモードではストリームの終わりまで受信してからまとめて作成します
+func (s *server) BulkCreateNotes(stream grpc.ClientStreamingServer[v1.BulkCreateNotesRequest, v1.BulkCreateNotesResponse]) error {
+	ctx := stream.Context()
+	resp := &v1.BulkCreateNotesResponse{}
+	allOrNothing := false
+	received := 0
+	bufferedBytes := 0
+	var inputs []usecase.NoteInput
+
+	flush := func() error {
+		if len(inputs) == 0 {
+			return nil
+		}
+		results, err := s.noteUsecase.BulkCreateNotes(ctx, inputs, allOrNothing)
+		if err != nil {
+			return toStatusError(err, "failed to create notes")
+		}
+		appendBulkCreateResults(resp, results)
+		inputs = inputs[:0]
+		return nil
+	}
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch payload := req.Payload.(type) {
+		case *v1.BulkCreateNotesRequest_Options:
+			if !first {
+				return status.Error(codes.InvalidArgument, "options must be the first message")
+			}
+			allOrNothing = payload.Options.GetMode() == v1.BulkCreateMode_BULK_CREATE_MODE_ALL_OR_NOTHING
+		case *v1.BulkCreateNotesRequest_Note:
+			if received == usecase.MaxBulkCreateNotes {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d notes can be created per stream", usecase.MaxBulkCreateNotes))
+			}
+			received++
+
+			input := usecase.NoteInput{
+				Title:      payload.Note.Title,
+				Content:    payload.Note.Content,
+				Tags:       payload.Note.Tags,
+				NotebookID: payload.Note.NotebookId,
+			}
+			if allOrNothing {
+				// 全件をまとめて作成するまで保持するため、保持する量に上限を設けます
+				bufferedBytes += noteInputBytes(input)
+				if bufferedBytes > maxBulkCreateBufferedBytes {
+					return status.Error(codes.InvalidArgument, fmt.Sprintf("notes in ALL_OR_NOTHING mode must total at most %d bytes", maxBulkCreateBufferedBytes))
+				}
+			}
+			inputs = append(inputs, input)
+
+			if !allOrNothing && len(inputs) == usecase.BulkCreateBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		default:
+			return status.Error(codes.InvalidArgument, "each message must carry options or a note")
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// appendBulkCreateResults はバッチの結果をストリーム内の位置を付けて応答に追加します
+func appendBulkCreateResults(resp *v1.BulkCreateNotesResponse, results []usecase.BulkCreateResult) {
+	for _, r := range results {
+		result := &v1.BulkCreateNoteResult{Index: int32(len(resp.Results))}
+		if r.Err != nil {
+			st := status.Convert(toStatusError(r.Err, "failed to create note"))
+			result.ErrorCode = int32(st.Code())
+			result.ErrorMessage = st.Message()
+			resp.FailedCount++
+		} else {
+			result.Id = r.Note.ID
+			result.Version = r.Note.Version
+			resp.CreatedCount++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+}
+
+// noteInputBytes はノートの入力が保持するおおよそのバイト数を返します
+func noteInputBytes(input usecase.NoteInput) int {
+	n := len(input.Title) + len(input.Content)
+	for _, tag := range input.Tags {
+		n += len(tag)
+	}
+	return n
+}
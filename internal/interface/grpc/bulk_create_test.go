@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBulkNoteUsecase はBulkCreateNotesの呼び出しを記録するNoteUsecaseです
+type fakeBulkNoteUsecase struct {
+	usecase.NoteUsecase
+	batches []int
+	nextID  int64
+}
+
+func (u *fakeBulkNoteUsecase) BulkCreateNotes(ctx context.Context, inputs []usecase.NoteInput, allOrNothing bool) ([]usecase.BulkCreateResult, error) {
+	u.batches = append(u.batches, len(inputs))
+	results := make([]usecase.BulkCreateResult, len(inputs))
+	for i, input := range inputs {
+		if input.Title == "" {
+			results[i].Err = &domain.ValidationError{Violations: []domain.FieldViolation{{Field: "title", Description: "is required"}}}
+			continue
+		}
+		u.nextID++
+		results[i].Note = &domain.Note{ID: u.nextID, Version: 1}
+	}
+	return results, nil
+}
+
+// fakeBulkCreateStream は用意したリクエストを順に受信し、応答を記録するストリームです
+type fakeBulkCreateStream struct {
+	grpc.ServerStream
+	reqs []*v1.BulkCreateNotesRequest
+	resp *v1.BulkCreateNotesResponse
+}
+
+func (s *fakeBulkCreateStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeBulkCreateStream) Recv() (*v1.BulkCreateNotesRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *fakeBulkCreateStream) SendAndClose(resp *v1.BulkCreateNotesResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func bulkCreateRequests(mode v1.BulkCreateMode, notes ...*v1.BulkCreateNoteItem) []*v1.BulkCreateNotesRequest {
+	reqs := []*v1.BulkCreateNotesRequest{{Payload: &v1.BulkCreateNotesRequest_Options{Options: &v1.BulkCreateNotesOptions{Mode: mode}}}}
+	for _, note := range notes {
+		reqs = append(reqs, &v1.BulkCreateNotesRequest{Payload: &v1.BulkCreateNotesRequest_Note{Note: note}})
+	}
+	return reqs
+}
+
+func TestBulkCreateNotesPartialWritesInBatches(t *testing.T) {
+	n := usecase.BulkCreateBatchSize*2 + 3
+	notes := make([]*v1.BulkCreateNoteItem, n)
+	for i := range notes {
+		notes[i] = &v1.BulkCreateNoteItem{Title: "title", Content: "content"}
+	}
+	// 2つ目のバッチの不正なノートはそのノートだけが失敗します
+	notes[usecase.BulkCreateBatchSize+1].Title = ""
+
+	notesUsecase := &fakeBulkNoteUsecase{}
+	s := &server{noteUsecase: notesUsecase}
+	stream := &fakeBulkCreateStream{reqs: bulkCreateRequests(v1.BulkCreateMode_BULK_CREATE_MODE_PARTIAL, notes...)}
+	if err := s.BulkCreateNotes(stream); err != nil {
+		t.Fatalf("BulkCreateNotes() error = %v", err)
+	}
+
+	want := []int{usecase.BulkCreateBatchSize, usecase.BulkCreateBatchSize, 3}
+	if len(notesUsecase.batches) != len(want) || notesUsecase.batches[0] != want[0] || notesUsecase.batches[1] != want[1] || notesUsecase.batches[2] != want[2] {
+		t.Errorf("batches = %v, want %v", notesUsecase.batches, want)
+	}
+	if stream.resp.CreatedCount != int32(n-1) || stream.resp.FailedCount != 1 || len(stream.resp.Results) != n {
+		t.Fatalf("response counts = %d created, %d failed, %d results", stream.resp.CreatedCount, stream.resp.FailedCount, len(stream.resp.Results))
+	}
+	for i, r := range stream.resp.Results {
+		if r.Index != int32(i) {
+			t.Fatalf("results[%d].Index = %d", i, r.Index)
+		}
+	}
+	if failed := stream.resp.Results[usecase.BulkCreateBatchSize+1]; failed.ErrorCode != int32(codes.InvalidArgument) {
+		t.Errorf("failed result = %+v, want InvalidArgument", failed)
+	}
+}
+
+func TestBulkCreateNotesAllOrNothingCapsBufferedBytes(t *testing.T) {
+	content := strings.Repeat("a", 60000)
+	var notes []*v1.BulkCreateNoteItem
+	for size := 0; size <= maxBulkCreateBufferedBytes; size += len(content) {
+		notes = append(notes, &v1.BulkCreateNoteItem{Title: "title", Content: content})
+	}
+
+	notesUsecase := &fakeBulkNoteUsecase{}
+	s := &server{noteUsecase: notesUsecase}
+	err := s.BulkCreateNotes(&fakeBulkCreateStream{reqs: bulkCreateRequests(v1.BulkCreateMode_BULK_CREATE_MODE_ALL_OR_NOTHING, notes...)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("BulkCreateNotes() error = %v, want InvalidArgument", err)
+	}
+	if len(notesUsecase.batches) != 0 {
+		t.Errorf("batches = %v, want nothing created", notesUsecase.batches)
+	}
+
+	// 上限内であれば全件をまとめて1回で作成します
+	notesUsecase = &fakeBulkNoteUsecase{}
+	s = &server{noteUsecase: notesUsecase}
+	stream := &fakeBulkCreateStream{reqs: bulkCreateRequests(v1.BulkCreateMode_BULK_CREATE_MODE_ALL_OR_NOTHING, notes[:usecase.BulkCreateBatchSize+1]...)}
+	if err := s.BulkCreateNotes(stream); err != nil {
+		t.Fatalf("BulkCreateNotes() error = %v", err)
+	}
+	if len(notesUsecase.batches) != 1 || notesUsecase.batches[0] != usecase.BulkCreateBatchSize+1 {
+		t.Errorf("batches = %v, want a single batch", notesUsecase.batches)
+	}
+}
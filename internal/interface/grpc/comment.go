@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddComment はAddComment RPCメソッドを実装します
+func (s *server) AddComment(ctx context.Context, req *v1.AddCommentRequest) (*v1.AddCommentResponse, error) {
+	comment, err := s.commentUsecase.AddComment(ctx, req.NoteId, req.ParentId, req.Body)
+	if err != nil {
+		return nil, toStatusError(err, "failed to add comment")
+	}
+
+	return &v1.AddCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+// ListComments はListComments RPCメソッドを実装します
+func (s *server) ListComments(ctx context.Context, req *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error) {
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, next, err := s.commentUsecase.ListComments(ctx, req.NoteId, req.ParentId, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list comments")
+	}
+
+	resp := &v1.ListCommentsResponse{NextPageToken: encodePageToken(next)}
+	for _, c := range comments {
+		resp.Comments = append(resp.Comments, toProtoComment(c))
+	}
+	return resp, nil
+}
+
+// EditComment はEditComment RPCメソッドを実装します
+func (s *server) EditComment(ctx context.Context, req *v1.EditCommentRequest) (*v1.EditCommentResponse, error) {
+	comment, err := s.commentUsecase.EditComment(ctx, req.NoteId, req.CommentId, req.Body)
+	if err != nil {
+		return nil, toStatusError(err, "failed to edit comment")
+	}
+
+	return &v1.EditCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+// DeleteComment はDeleteComment RPCメソッドを実装します
+func (s *server) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error) {
+	if err := s.commentUsecase.DeleteComment(ctx, req.NoteId, req.CommentId); err != nil {
+		return nil, toStatusError(err, "failed to delete comment")
+	}
+
+	return &v1.DeleteCommentResponse{}, nil
+}
+
+// toProtoComment はドメインのコメントをprotoメッセージに変換します
+func toProtoComment(c *domain.Comment) *v1.Comment {
+	return &v1.Comment{
+		Id:         c.ID,
+		NoteId:     c.NoteID,
+		ParentId:   c.ParentID,
+		Author:     c.Author,
+		Body:       c.Body,
+		ReplyCount: c.ReplyCount,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+		Deleted:    c.IsDeleted(),
+	}
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// defaultDiffContextSize は文脈の行数（単語数）が指定されなかった場合の値です
+const defaultDiffContextSize = 3
+
+// DiffNote はDiffNote RPCメソッドを実装します
+func (s *server) DiffNote(ctx context.Context, req *v1.DiffNoteRequest) (*v1.DiffNoteResponse, error) {
+	granularity := domain.DiffByLine
+	if req.Granularity == v1.DiffGranularity_DIFF_GRANULARITY_WORD {
+		granularity = domain.DiffByWord
+	}
+	contextSize := defaultDiffContextSize
+	if req.ContextSize != nil {
+		contextSize = int(req.GetContextSize())
+	}
+
+	diff, err := s.revisionUsecase.DiffRevisions(ctx, req.NoteId, req.FromVersion, req.ToVersion, granularity, contextSize)
+	if err != nil {
+		return nil, toStatusError(err, "failed to diff note")
+	}
+
+	resp := &v1.DiffNoteResponse{
+		NoteId:      diff.NoteID,
+		FromVersion: diff.FromVersion,
+		ToVersion:   diff.ToVersion,
+		OldTitle:    diff.OldTitle,
+		NewTitle:    diff.NewTitle,
+		UnifiedDiff: diff.Unified,
+	}
+	for _, h := range diff.Hunks {
+		hunk := &v1.DiffHunk{
+			OldStart: int32(h.OldStart),
+			OldCount: int32(h.OldCount),
+			NewStart: int32(h.NewStart),
+			NewCount: int32(h.NewCount),
+		}
+		for _, e := range h.Edits {
+			hunk.Edits = append(hunk.Edits, &v1.DiffEdit{Op: toProtoDiffOp(e.Op), Text: e.Text})
+		}
+		resp.Hunks = append(resp.Hunks, hunk)
+	}
+	return resp, nil
+}
+
+// toProtoDiffOp はドメインの差分操作をprotobufの列挙値に変換します
+func toProtoDiffOp(op domain.DiffOp) v1.DiffEdit_Op {
+	switch op {
+	case domain.DiffDelete:
+		return v1.DiffEdit_OP_DELETE
+	case domain.DiffInsert:
+		return v1.DiffEdit_OP_INSERT
+	default:
+		return v1.DiffEdit_OP_EQUAL
+	}
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"errors"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError はユースケースのエラーを対応するgRPCステータスに変換します
+// 対応するステータスがない場合はmsgを付けてInternalとして返します
+func toStatusError(err error, msg string) error {
+	var verr *domain.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		return validationStatus(verr)
+	case errors.Is(err, domain.ErrNoteNotFound), errors.Is(err, domain.ErrRevisionNotFound), errors.Is(err, domain.ErrNotebookNotFound),
+		errors.Is(err, domain.ErrTemplateNotFound), errors.Is(err, domain.ErrAttachmentNotFound), errors.Is(err, domain.ErrCommentNotFound),
+		errors.Is(err, domain.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, domain.ErrNoteNotTrashed), errors.Is(err, domain.ErrNotebookCycle), errors.Is(err, domain.ErrNotebookNotEmpty),
+		errors.Is(err, domain.ErrNoteArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, usecase.ErrIdempotencyKeyReused), errors.Is(err, domain.ErrAttachmentTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrIdempotencyInProgress), errors.Is(err, domain.ErrWatchLagging), errors.Is(err, usecase.ErrBulkCreateAborted):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, domain.ErrWatchCursorExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// validationStatus はドメインの検証エラーをBadRequest詳細付きのInvalidArgumentに変換します
+func validationStatus(verr *domain.ValidationError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(verr.Violations))
+	for _, v := range verr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return badRequestStatus(verr.Error(), violations)
+}
+
+// badRequestStatus はフィールド違反の一覧をBadRequest詳細付きのInvalidArgumentに変換します
+func badRequestStatus(msg string, violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
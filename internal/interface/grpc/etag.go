@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"fmt"
+	"go_test/internal/domain"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// noteETag はノートのバージョンからETagを生成します
+func noteETag(note *domain.Note) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(note.Version, 10))
+}
+
+// parseNoteETag はETagからバージョンを取り出します
+func parseNoteETag(etag string) (int64, error) {
+	v := strings.TrimPrefix(etag, "W/")
+	unquoted, err := strconv.Unquote(v)
+	if err != nil {
+		return 0, fmt.Errorf("malformed etag %q", etag)
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("malformed etag %q", etag)
+	}
+	return version, nil
+}
+
+// expectedNoteVersion は更新系リクエストのexpected_versionまたはetagから期待するバージョンを決定します
+func expectedNoteVersion(expectedVersion int64, etag string) (int64, error) {
+	switch {
+	case expectedVersion > 0 && etag != "":
+		return 0, status.Error(codes.InvalidArgument, "only one of expected_version or etag may be set")
+	case expectedVersion > 0:
+		return expectedVersion, nil
+	case etag != "":
+		version, err := parseNoteETag(etag)
+		if err != nil {
+			return 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return version, nil
+	}
+	return 0, status.Error(codes.InvalidArgument, "expected_version or etag is required")
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/interface/archive"
+	"go_test/internal/usecase"
+	v1 "go_test/proto/go_test/v1"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// exportChunkSize はExportNotesで1メッセージに載せる最大バイト数です
+const exportChunkSize = 64 << 10
+
+// exportFormats はprotoの列挙値とアーカイブ形式の対応です
+var exportFormats = map[v1.ExportFormat]archive.Format{
+	v1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:  archive.FormatMarkdownZip,
+	v1.ExportFormat_EXPORT_FORMAT_MARKDOWN_ZIP: archive.FormatMarkdownZip,
+	v1.ExportFormat_EXPORT_FORMAT_MARKDOWN_TAR: archive.FormatMarkdownTar,
+	v1.ExportFormat_EXPORT_FORMAT_NDJSON:       archive.FormatNDJSON,
+	v1.ExportFormat_EXPORT_FORMAT_CSV:          archive.FormatCSV,
+}
+
+// ExportNotes はExportNotes RPCメソッドを実装します
+// アーカイブはメモリに溜めずに、書き込まれた順にチャンクに分けて送信します
+func (s *server) ExportNotes(req *v1.ExportNotesRequest, stream grpc.ServerStreamingServer[v1.ExportNotesResponse]) error {
+	ctx := stream.Context()
+	format := exportFormats[req.Format]
+
+	filter := usecase.ExportFilter{Tags: req.Tags}
+	if req.UpdatedFrom != nil {
+		filter.UpdatedFrom = req.UpdatedFrom.AsTime()
+	}
+	if req.UpdatedTo != nil {
+		filter.UpdatedTo = req.UpdatedTo.AsTime()
+	}
+
+	filename := fmt.Sprintf("notes-%s%s", time.Now().UTC().Format("20060102T150405Z"), format.Extension())
+	if err := stream.Send(&v1.ExportNotesResponse{
+		Data: &v1.ExportNotesResponse_Metadata{Metadata: &v1.ExportMetadata{
+			Filename:    filename,
+			ContentType: format.ContentType(),
+		}},
+	}); err != nil {
+		return err
+	}
+
+	cw := &chunkWriter{stream: stream, buf: make([]byte, 0, exportChunkSize)}
+	w, err := archive.NewWriter(cw, format)
+	if err != nil {
+		return toStatusError(err, "failed to export notes")
+	}
+	count, err := s.exportUsecase.ExportNotes(ctx, filter, func(note *domain.Note) error {
+		return w.WriteNote(note)
+	})
+	if cw.err != nil {
+		// 送信時のエラー（クライアントの切断など）はそのまま返します
+		return cw.err
+	}
+	if err != nil {
+		return toStatusError(err, "failed to export notes")
+	}
+	if err := w.Close(); err != nil {
+		if cw.err != nil {
+			return cw.err
+		}
+		return toStatusError(err, "failed to export notes")
+	}
+	if err := cw.flush(); err != nil {
+		return err
+	}
+
+	return stream.Send(&v1.ExportNotesResponse{
+		Data: &v1.ExportNotesResponse_Summary{Summary: &v1.ExportSummary{NoteCount: int64(count)}},
+	})
+}
+
+// chunkWriter は書き込まれたバイト列をexportChunkSizeごとにExportNotesのチャンクとして送信するio.Writerです
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[v1.ExportNotesResponse]
+	buf    []byte
+	err    error
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), exportChunkSize-len(c.buf))
+		c.buf = append(c.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(c.buf) == exportChunkSize {
+			if err := c.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// flush はバッファに残っているバイト列を送信します
+func (c *chunkWriter) flush() error {
+	if c.err != nil {
+		return c.err
+	}
+	if len(c.buf) == 0 {
+		return nil
+	}
+	if err := c.stream.Send(&v1.ExportNotesResponse{
+		Data: &v1.ExportNotesResponse_Chunk{Chunk: c.buf},
+	}); err != nil {
+		c.err = err
+		return err
+	}
+	// 送信したメッセージはSendから戻った後も変更してはいけないため、新しいバッファを使います
+	c.buf = make([]byte, 0, exportChunkSize)
+	return nil
+}
@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"go_test/internal/interface/archive"
+	"go_test/internal/usecase"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxImportFileBytes はImportNotesで受け付ける1ファイルの最大バイト数です
+const maxImportFileBytes = 32 << 20
+
+// ImportNotes はImportNotes RPCメソッドを実装します
+// ファイルの最後のチャンクを受信するたびに、そのファイルのノートをインポートします
+func (s *server) ImportNotes(stream grpc.ClientStreamingServer[v1.ImportNotesRequest, v1.ImportNotesResponse]) error {
+	resp := &v1.ImportNotesResponse{}
+	var options *v1.ImportOptions
+	var filename string
+	var data bytes.Buffer
+	started := false
+
+	flush := func() error {
+		if !started {
+			return nil
+		}
+		result, err := s.importFile(stream, options, filename, data.Bytes())
+		if err != nil {
+			return err
+		}
+		resp.Files = append(resp.Files, result)
+		if result.ErrorCode != int32(codes.OK) {
+			resp.FailedFileCount++
+		}
+		for _, note := range result.Notes {
+			switch note.Status {
+			case v1.ImportNoteStatus_IMPORT_NOTE_STATUS_CREATED:
+				resp.CreatedCount++
+			case v1.ImportNoteStatus_IMPORT_NOTE_STATUS_DUPLICATE:
+				resp.DuplicateCount++
+			default:
+				resp.FailedCount++
+			}
+		}
+		data.Reset()
+		return nil
+	}
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch payload := req.Payload.(type) {
+		case *v1.ImportNotesRequest_Options:
+			if !first {
+				return status.Error(codes.InvalidArgument, "options must be the first message")
+			}
+			options = payload.Options
+		case *v1.ImportNotesRequest_File:
+			if err := flush(); err != nil {
+				return err
+			}
+			filename = payload.File.Filename
+			started = true
+		case *v1.ImportNotesRequest_Chunk:
+			if !started {
+				return status.Error(codes.InvalidArgument, "a file message must precede its chunks")
+			}
+			if data.Len()+len(payload.Chunk) > maxImportFileBytes {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("file %q exceeds %d bytes", filename, maxImportFileBytes))
+			}
+			data.Write(payload.Chunk)
+		default:
+			return status.Error(codes.InvalidArgument, "each message must carry options, a file or a chunk")
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// importFile は1ファイル分のノートを解析してインポートし、その結果を返します
+// ファイルの解析やインポートの失敗はファイルの結果として報告し、クライアントの切断の場合だけエラーを返します
+func (s *server) importFile(stream grpc.ClientStreamingServer[v1.ImportNotesRequest, v1.ImportNotesResponse], options *v1.ImportOptions, filename string, data []byte) (*v1.ImportFileResult, error) {
+	result := &v1.ImportFileResult{Filename: filename}
+
+	entries, err := archive.Decode(filename, data)
+	if err != nil {
+		result.ErrorCode = int32(codes.InvalidArgument)
+		result.ErrorMessage = err.Error()
+		return result, nil
+	}
+
+	inputs := make([]usecase.NoteInput, len(entries))
+	for i, entry := range entries {
+		inputs[i] = usecase.NoteInput{
+			Title:      entry.Title,
+			Content:    entry.Content,
+			Tags:       append(entry.Tags, options.GetTags()...),
+			NotebookID: options.GetNotebookId(),
+			CreatedAt:  entry.CreatedAt,
+		}
+	}
+
+	imported, err := s.importUsecase.ImportNotes(stream.Context(), inputs)
+	if stream.Context().Err() != nil {
+		return nil, stream.Context().Err()
+	}
+	if err != nil {
+		st := status.Convert(toStatusError(err, "failed to import notes"))
+		result.ErrorCode = int32(st.Code())
+		result.ErrorMessage = st.Message()
+		return result, nil
+	}
+
+	result.Notes = make([]*v1.ImportNoteResult, len(imported))
+	for i, r := range imported {
+		note := &v1.ImportNoteResult{Index: int32(i), Title: entries[i].Title}
+		switch {
+		case r.Err != nil:
+			st := status.Convert(toStatusError(r.Err, "failed to import note"))
+			note.Status = v1.ImportNoteStatus_IMPORT_NOTE_STATUS_FAILED
+			note.ErrorCode = int32(st.Code())
+			note.ErrorMessage = st.Message()
+		case r.DuplicateOf != 0:
+			note.Status = v1.ImportNoteStatus_IMPORT_NOTE_STATUS_DUPLICATE
+			note.NoteId = r.DuplicateOf
+		default:
+			note.Status = v1.ImportNoteStatus_IMPORT_NOTE_STATUS_CREATED
+			note.NoteId = r.Note.ID
+		}
+		result.Notes[i] = note
+	}
+	return result, nil
+}
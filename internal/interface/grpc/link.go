@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// GetBacklinks はGetBacklinks RPCメソッドを実装します
+func (s *server) GetBacklinks(ctx context.Context, req *v1.GetBacklinksRequest) (*v1.GetBacklinksResponse, error) {
+	beforeID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, next, err := s.linkUsecase.GetBacklinks(ctx, req.NoteId, int(req.PageSize), beforeID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get backlinks")
+	}
+
+	resp := &v1.GetBacklinksResponse{NextPageToken: encodePageToken(next)}
+	for _, note := range notes {
+		resp.Notes = append(resp.Notes, toProtoNote(note))
+	}
+	return resp, nil
+}
+
+// GetOutgoingLinks はGetOutgoingLinks RPCメソッドを実装します
+func (s *server) GetOutgoingLinks(ctx context.Context, req *v1.GetOutgoingLinksRequest) (*v1.GetOutgoingLinksResponse, error) {
+	links, err := s.linkUsecase.GetOutgoingLinks(ctx, req.NoteId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get outgoing links")
+	}
+
+	resp := &v1.GetOutgoingLinksResponse{}
+	for _, link := range links {
+		resp.Links = append(resp.Links, &v1.NoteLink{
+			Target:       link.Link.Target(),
+			TargetNoteId: link.TargetNoteID,
+			TargetTitle:  link.TargetTitle,
+		})
+	}
+	return resp, nil
+}
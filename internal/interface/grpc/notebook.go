@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateNotebook はCreateNotebook RPCメソッドを実装します
+func (s *server) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (*v1.CreateNotebookResponse, error) {
+	notebook, err := s.notebookUsecase.CreateNotebook(ctx, req.Name, req.ParentId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create notebook")
+	}
+
+	return &v1.CreateNotebookResponse{Notebook: toProtoNotebook(notebook)}, nil
+}
+
+// GetNotebook はGetNotebook RPCメソッドを実装します
+func (s *server) GetNotebook(ctx context.Context, req *v1.GetNotebookRequest) (*v1.GetNotebookResponse, error) {
+	notebook, err := s.notebookUsecase.GetNotebook(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get notebook")
+	}
+
+	return &v1.GetNotebookResponse{Notebook: toProtoNotebook(notebook)}, nil
+}
+
+// UpdateNotebook はUpdateNotebook RPCメソッドを実装します
+func (s *server) UpdateNotebook(ctx context.Context, req *v1.UpdateNotebookRequest) (*v1.UpdateNotebookResponse, error) {
+	notebook, err := s.notebookUsecase.UpdateNotebook(ctx, req.Id, req.Name, req.ParentId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update notebook")
+	}
+
+	return &v1.UpdateNotebookResponse{Notebook: toProtoNotebook(notebook)}, nil
+}
+
+// DeleteNotebook はDeleteNotebook RPCメソッドを実装します
+func (s *server) DeleteNotebook(ctx context.Context, req *v1.DeleteNotebookRequest) (*v1.DeleteNotebookResponse, error) {
+	if err := s.notebookUsecase.DeleteNotebook(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to delete notebook")
+	}
+
+	return &v1.DeleteNotebookResponse{}, nil
+}
+
+// ListNotebooks はListNotebooks RPCメソッドを実装します
+func (s *server) ListNotebooks(ctx context.Context, req *v1.ListNotebooksRequest) (*v1.ListNotebooksResponse, error) {
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	notebooks, next, err := s.notebookUsecase.ListNotebooks(ctx, req.ParentId, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list notebooks")
+	}
+
+	resp := &v1.ListNotebooksResponse{NextPageToken: encodePageToken(next)}
+	for _, notebook := range notebooks {
+		resp.Notebooks = append(resp.Notebooks, toProtoNotebook(notebook))
+	}
+	return resp, nil
+}
+
+// MoveNote はMoveNote RPCメソッドを実装します
+func (s *server) MoveNote(ctx context.Context, req *v1.MoveNoteRequest) (*v1.MoveNoteResponse, error) {
+	note, err := s.notebookUsecase.MoveNote(ctx, req.Id, req.NotebookId)
+	if err != nil {
+		return nil, toStatusError(err, "failed to move note")
+	}
+
+	return &v1.MoveNoteResponse{Note: toProtoNote(note)}, nil
+}
+
+// toProtoNotebook はドメインのノートブックをprotobufメッセージに変換します
+func toProtoNotebook(notebook *domain.Notebook) *v1.Notebook {
+	return &v1.Notebook{
+		Id:        notebook.ID,
+		Name:      notebook.Name,
+		ParentId:  notebook.ParentID,
+		CreatedAt: timestamppb.New(notebook.CreatedAt),
+		UpdatedAt: timestamppb.New(notebook.UpdatedAt),
+	}
+}
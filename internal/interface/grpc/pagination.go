@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"go_test/internal/usecase"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encodePageToken はページングカーソルをクライアントに返す不透明なトークンに変換します
+func encodePageToken(cursor int64) string {
+	if cursor == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(cursor, 10)))
+}
+
+// decodePageToken はページトークンからカーソルを取り出します。空のトークンは0を返します
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	cursor, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || cursor <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return cursor, nil
+}
+
+// pinnedCursorPrefix は固定されたノートの位置を指すノート一覧カーソルの接頭辞です
+const pinnedCursorPrefix = "p"
+
+// encodeNoteCursor はノート一覧のカーソルをページトークンに変換します
+func encodeNoteCursor(cursor usecase.NoteCursor) string {
+	if cursor.ID == 0 {
+		return ""
+	}
+	v := strconv.FormatInt(cursor.ID, 10)
+	if cursor.Pinned {
+		v = pinnedCursorPrefix + v
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(v))
+}
+
+// decodeNoteCursor はページトークンからノート一覧のカーソルを取り出します。空のトークンはIDが0のカーソルを返します
+func decodeNoteCursor(token string) (usecase.NoteCursor, error) {
+	if token == "" {
+		return usecase.NoteCursor{}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return usecase.NoteCursor{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	v, pinned := strings.CutPrefix(string(b), pinnedCursorPrefix)
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || id <= 0 {
+		return usecase.NoteCursor{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return usecase.NoteCursor{Pinned: pinned, ID: id}, nil
+}
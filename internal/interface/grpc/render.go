@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// RenderNote はRenderNote RPCメソッドを実装します
+func (s *server) RenderNote(ctx context.Context, req *v1.RenderNoteRequest) (*v1.RenderNoteResponse, error) {
+	note, html, err := s.renderUsecase.RenderNote(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to render note")
+	}
+
+	return &v1.RenderNoteResponse{
+		NoteId:  note.ID,
+		Version: note.Version,
+		Etag:    noteETag(note),
+		Html:    html,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListNoteRevisions はListNoteRevisions RPCメソッドを実装します
+func (s *server) ListNoteRevisions(ctx context.Context, req *v1.ListNoteRevisionsRequest) (*v1.ListNoteRevisionsResponse, error) {
+	beforeVersion, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, next, err := s.revisionUsecase.ListRevisions(ctx, req.NoteId, int(req.PageSize), beforeVersion)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list note revisions")
+	}
+
+	resp := &v1.ListNoteRevisionsResponse{NextPageToken: encodePageToken(next)}
+	for _, revision := range revisions {
+		resp.Revisions = append(resp.Revisions, toProtoRevision(revision))
+	}
+	return resp, nil
+}
+
+// GetNoteRevision はGetNoteRevision RPCメソッドを実装します
+func (s *server) GetNoteRevision(ctx context.Context, req *v1.GetNoteRevisionRequest) (*v1.GetNoteRevisionResponse, error) {
+	revision, err := s.revisionUsecase.GetRevision(ctx, req.NoteId, req.Version)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get note revision")
+	}
+
+	return &v1.GetNoteRevisionResponse{Revision: toProtoRevision(revision)}, nil
+}
+
+// RestoreNoteRevision はRestoreNoteRevision RPCメソッドを実装します
+func (s *server) RestoreNoteRevision(ctx context.Context, req *v1.RestoreNoteRevisionRequest) (*v1.RestoreNoteRevisionResponse, error) {
+	var expectedVersion int64
+	if req.ExpectedVersion > 0 || req.Etag != "" {
+		var err error
+		expectedVersion, err = expectedNoteVersion(req.ExpectedVersion, req.Etag)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	note, err := s.revisionUsecase.RestoreRevision(ctx, req.NoteId, req.Version, expectedVersion)
+	if err != nil {
+		return nil, toStatusError(err, "failed to restore note revision")
+	}
+
+	return &v1.RestoreNoteRevisionResponse{
+		Id:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		Version:    note.Version,
+		Etag:       noteETag(note),
+		Tags:       note.Tags,
+		NotebookId: note.NotebookID,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
+	}, nil
+}
+
+// toProtoRevision はドメインのリビジョンをprotobufメッセージに変換します
+func toProtoRevision(revision *domain.NoteRevision) *v1.NoteRevision {
+	return &v1.NoteRevision{
+		NoteId:    revision.NoteID,
+		Version:   revision.Version,
+		Title:     revision.Title,
+		Content:   revision.Content,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
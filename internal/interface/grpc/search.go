@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// SearchNotes はSearchNotes RPCメソッドを実装します
+func (s *server) SearchNotes(ctx context.Context, req *v1.SearchNotesRequest) (*v1.SearchNotesResponse, error) {
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 既定のHTMLタグを使う場合のみスニペットをHTMLエスケープします
+	tags := domain.DefaultHighlightTags
+	if req.HighlightPreTag != "" || req.HighlightPostTag != "" {
+		tags = domain.HighlightTags{Pre: req.HighlightPreTag, Post: req.HighlightPostTag}
+	}
+
+	hits, next, err := s.noteUsecase.SearchNotes(ctx, req.Query, int(req.PageSize), int(offset), tags)
+	if err != nil {
+		return nil, toStatusError(err, "failed to search notes")
+	}
+
+	resp := &v1.SearchNotesResponse{NextPageToken: encodePageToken(int64(next))}
+	for _, hit := range hits {
+		resp.Results = append(resp.Results, &v1.SearchResult{
+			Note:           toProtoNote(hit.Note),
+			Score:          hit.Score,
+			TitleSnippet:   hit.TitleSnippet,
+			ContentSnippet: hit.ContentSnippet,
+		})
+	}
+	return resp, nil
+}
@@ -4,31 +4,64 @@ import (
 	"context"
 	"go_test/internal/usecase"
 	v1 "go_test/proto/go_test/v1"
+	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // server はgRPCサービスを実装します
 type server struct {
 	v1.UnimplementedGoTestServiceServer
-	noteUsecase usecase.NoteUsecase
-	pingUsecase usecase.PingUsecase
+	noteUsecase       usecase.NoteUsecase
+	revisionUsecase   usecase.NoteRevisionUsecase
+	trashUsecase      usecase.TrashUsecase
+	tagUsecase        usecase.TagUsecase
+	notebookUsecase   usecase.NotebookUsecase
+	renderUsecase     usecase.RenderUsecase
+	linkUsecase       usecase.LinkUsecase
+	templateUsecase   usecase.TemplateUsecase
+	attachmentUsecase usecase.AttachmentUsecase
+	commentUsecase    usecase.CommentUsecase
+	watchUsecase      usecase.WatchUsecase
+	webhookUsecase    usecase.WebhookUsecase
+	exportUsecase     usecase.ExportUsecase
+	importUsecase     usecase.ImportUsecase
+	pingUsecase       usecase.PingUsecase
 }
 
 // NewServer は新しいgRPCサーバーを作成します
-func NewServer(noteUsecase usecase.NoteUsecase, pingUsecase usecase.PingUsecase) *grpc.Server {
+func NewServer(noteUsecase usecase.NoteUsecase, revisionUsecase usecase.NoteRevisionUsecase, trashUsecase usecase.TrashUsecase, tagUsecase usecase.TagUsecase, notebookUsecase usecase.NotebookUsecase, renderUsecase usecase.RenderUsecase, linkUsecase usecase.LinkUsecase, templateUsecase usecase.TemplateUsecase, attachmentUsecase usecase.AttachmentUsecase, commentUsecase usecase.CommentUsecase, watchUsecase usecase.WatchUsecase, webhookUsecase usecase.WebhookUsecase, exportUsecase usecase.ExportUsecase, importUsecase usecase.ImportUsecase, pingUsecase usecase.PingUsecase, authenticator usecase.Authenticator) *grpc.Server {
 	s := &server{
-		noteUsecase: noteUsecase,
-		pingUsecase: pingUsecase,
+		noteUsecase:       noteUsecase,
+		revisionUsecase:   revisionUsecase,
+		trashUsecase:      trashUsecase,
+		tagUsecase:        tagUsecase,
+		notebookUsecase:   notebookUsecase,
+		renderUsecase:     renderUsecase,
+		linkUsecase:       linkUsecase,
+		templateUsecase:   templateUsecase,
+		attachmentUsecase: attachmentUsecase,
+		commentUsecase:    commentUsecase,
+		watchUsecase:      watchUsecase,
+		webhookUsecase:    webhookUsecase,
+		exportUsecase:     exportUsecase,
+		importUsecase:     importUsecase,
+		pingUsecase:       pingUsecase,
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authUnaryInterceptor(authenticator), validationUnaryInterceptor),
+		grpc.ChainStreamInterceptor(authStreamInterceptor(authenticator), validationStreamInterceptor),
+	)
 	v1.RegisterGoTestServiceServer(grpcServer, s)
 	reflection.Register(grpcServer)
 	
@@ -56,41 +89,147 @@ func (s *server) Ping(ctx context.Context, req *v1.PingRequest) (*v1.PingRespons
 
 // CreateNote はCreateNote RPCメソッドを実装します
 func (s *server) CreateNote(ctx context.Context, req *v1.CreateNoteRequest) (*v1.CreateNoteResponse, error) {
-	if req.Title == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "title is required")
-	}
-	if req.Content == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	key, err := idempotencyKey(ctx, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
 	}
-
-	note, err := s.noteUsecase.CreateNote(ctx, req.Title, req.Content)
+	note, err := s.noteUsecase.CreateNote(ctx, req.Title, req.Content, req.Tags, req.NotebookId, key)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create note: %v", err)
+		return nil, toStatusError(err, "failed to create note")
 	}
 
 	return &v1.CreateNoteResponse{
-		Id:        note.ID,
-		Title:     note.Title,
-		Content:   note.Content,
-		CreatedAt: timestamppb.New(note.CreatedAt),
+		Id:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		Version:    note.Version,
+		Etag:       noteETag(note),
+		Tags:       note.Tags,
+		NotebookId: note.NotebookID,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
 	}, nil
 }
 
-// GetNote はGetNote RPCメソッドを実装します
-func (s *server) GetNote(ctx context.Context, req *v1.GetNoteRequest) (*v1.GetNoteResponse, error) {
-	if req.Id <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+// idempotencyKey はリクエストフィールドまたは"idempotency-key"メタデータから冪等キーを取得します
+// メタデータの値はバリデーションインターセプターを通らないため、idempotency_keyフィールドと同じルールで検証します
+func idempotencyKey(ctx context.Context, field string) (string, error) {
+	if field != "" {
+		return field, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get("idempotency-key")
+	if len(values) == 0 {
+		return "", nil
 	}
 
+	key := values[0]
+	var violations []*errdetails.BadRequest_FieldViolation
+	validateString(key, idempotencyKeyRules(), "metadata.idempotency-key", func(field, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+	})
+	if len(violations) > 0 {
+		descs := make([]string, 0, len(violations))
+		for _, v := range violations {
+			descs = append(descs, v.Field+": "+v.Description)
+		}
+		return "", badRequestStatus("validation failed: "+strings.Join(descs, "; "), violations)
+	}
+	return key, nil
+}
+
+// idempotencyKeyRules はCreateNoteRequest.idempotency_keyフィールドに付けられた文字列ルールを返します
+func idempotencyKeyRules() *v1.StringRules {
+	fd := (&v1.CreateNoteRequest{}).ProtoReflect().Descriptor().Fields().ByName("idempotency_key")
+	rules, _ := proto.GetExtension(fd.Options(), v1.E_Rules).(*v1.FieldRules)
+	return rules.GetString_()
+}
+
+// GetNote はGetNote RPCメソッドを実装します
+func (s *server) GetNote(ctx context.Context, req *v1.GetNoteRequest) (*v1.GetNoteResponse, error) {
 	note, err := s.noteUsecase.GetNote(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get note: %v", err)
+		return nil, toStatusError(err, "failed to get note")
+	}
+
+	var contentHTML string
+	if req.RenderHtml {
+		contentHTML, err = s.renderUsecase.RenderContent(ctx, note)
+		if err != nil {
+			return nil, toStatusError(err, "failed to render note")
+		}
 	}
 
 	return &v1.GetNoteResponse{
-		Id:        note.ID,
-		Title:     note.Title,
-		Content:   note.Content,
-		CreatedAt: timestamppb.New(note.CreatedAt),
+		Id:          note.ID,
+		Title:       note.Title,
+		Content:     note.Content,
+		CreatedAt:   timestamppb.New(note.CreatedAt),
+		UpdatedAt:   timestamppb.New(note.UpdatedAt),
+		Version:     note.Version,
+		Etag:        noteETag(note),
+		Tags:        note.Tags,
+		NotebookId:  note.NotebookID,
+		Pinned:      note.Pinned,
+		Archived:    note.Archived,
+		ContentHtml: contentHTML,
+	}, nil
+}
+
+// BatchGetNotes はBatchGetNotes RPCメソッドを実装します
+func (s *server) BatchGetNotes(ctx context.Context, req *v1.BatchGetNotesRequest) (*v1.BatchGetNotesResponse, error) {
+	notes, err := s.noteUsecase.BatchGetNotes(ctx, req.Ids)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get notes")
+	}
+
+	resp := &v1.BatchGetNotesResponse{Results: make([]*v1.BatchGetNoteResult, len(notes))}
+	for i, note := range notes {
+		result := &v1.BatchGetNoteResult{Id: req.Ids[i]}
+		if note == nil {
+			result.NotFound = true
+		} else {
+			result.Note = toProtoNote(note)
+		}
+		resp.Results[i] = result
+	}
+	return resp, nil
+}
+
+// UpdateNote はUpdateNote RPCメソッドを実装します
+func (s *server) UpdateNote(ctx context.Context, req *v1.UpdateNoteRequest) (*v1.UpdateNoteResponse, error) {
+	expectedVersion, err := expectedNoteVersion(req.ExpectedVersion, req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	// tagsが指定された場合のみ置き換えます（空のリストはすべてのタグを外します）
+	var tags []string
+	if req.Tags != nil {
+		tags = append([]string{}, req.Tags.Tags...)
+	}
+
+	note, err := s.noteUsecase.UpdateNote(ctx, req.Id, req.Title, req.Content, tags, expectedVersion)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update note")
+	}
+
+	return &v1.UpdateNoteResponse{
+		Id:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		Version:    note.Version,
+		Etag:       noteETag(note),
+		Tags:       note.Tags,
+		NotebookId: note.NotebookID,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
 	}, nil
 }
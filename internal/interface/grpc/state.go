@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"context"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// PinNote はPinNote RPCメソッドを実装します
+func (s *server) PinNote(ctx context.Context, req *v1.PinNoteRequest) (*v1.PinNoteResponse, error) {
+	note, err := s.noteUsecase.PinNote(ctx, req.Id, req.Pinned)
+	if err != nil {
+		return nil, toStatusError(err, "failed to pin note")
+	}
+
+	return &v1.PinNoteResponse{Note: toProtoNote(note)}, nil
+}
+
+// ArchiveNote はArchiveNote RPCメソッドを実装します
+func (s *server) ArchiveNote(ctx context.Context, req *v1.ArchiveNoteRequest) (*v1.ArchiveNoteResponse, error) {
+	note, err := s.noteUsecase.ArchiveNote(ctx, req.Id, req.Archived)
+	if err != nil {
+		return nil, toStatusError(err, "failed to archive note")
+	}
+
+	return &v1.ArchiveNoteResponse{Note: toProtoNote(note)}, nil
+}
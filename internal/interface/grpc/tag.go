@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/usecase"
+	v1 "go_test/proto/go_test/v1"
+)
+
+// ListNotes はListNotes RPCメソッドを実装します
+func (s *server) ListNotes(ctx context.Context, req *v1.ListNotesRequest) (*v1.ListNotesResponse, error) {
+	after, err := decodeNoteCursor(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := usecase.NoteFilter{
+		Tags:               req.Tags,
+		NotebookID:         req.NotebookId,
+		IncludeDescendants: req.IncludeDescendants,
+		IncludeArchived:    req.IncludeArchived,
+	}
+	notes, next, err := s.noteUsecase.ListNotes(ctx, filter, int(req.PageSize), after)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list notes")
+	}
+
+	resp := &v1.ListNotesResponse{NextPageToken: encodeNoteCursor(next)}
+	for _, note := range notes {
+		resp.Notes = append(resp.Notes, toProtoNote(note))
+	}
+	return resp, nil
+}
+
+// ListTags はListTags RPCメソッドを実装します
+func (s *server) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	tags, err := s.tagUsecase.ListTags(ctx)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list tags")
+	}
+
+	resp := &v1.ListTagsResponse{}
+	for _, tag := range tags {
+		resp.Tags = append(resp.Tags, &v1.TagCount{
+			Name:      tag.Name,
+			NoteCount: tag.Count,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateNoteTemplate はCreateNoteTemplate RPCメソッドを実装します
+func (s *server) CreateNoteTemplate(ctx context.Context, req *v1.CreateNoteTemplateRequest) (*v1.CreateNoteTemplateResponse, error) {
+	t, err := s.templateUsecase.CreateTemplate(ctx, req.Name, req.TitleTemplate, req.ContentTemplate)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create note template")
+	}
+
+	return &v1.CreateNoteTemplateResponse{Template: toProtoTemplate(t)}, nil
+}
+
+// GetNoteTemplate はGetNoteTemplate RPCメソッドを実装します
+func (s *server) GetNoteTemplate(ctx context.Context, req *v1.GetNoteTemplateRequest) (*v1.GetNoteTemplateResponse, error) {
+	t, err := s.templateUsecase.GetTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get note template")
+	}
+
+	return &v1.GetNoteTemplateResponse{Template: toProtoTemplate(t)}, nil
+}
+
+// UpdateNoteTemplate はUpdateNoteTemplate RPCメソッドを実装します
+func (s *server) UpdateNoteTemplate(ctx context.Context, req *v1.UpdateNoteTemplateRequest) (*v1.UpdateNoteTemplateResponse, error) {
+	t, err := s.templateUsecase.UpdateTemplate(ctx, req.Id, req.Name, req.TitleTemplate, req.ContentTemplate)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update note template")
+	}
+
+	return &v1.UpdateNoteTemplateResponse{Template: toProtoTemplate(t)}, nil
+}
+
+// DeleteNoteTemplate はDeleteNoteTemplate RPCメソッドを実装します
+func (s *server) DeleteNoteTemplate(ctx context.Context, req *v1.DeleteNoteTemplateRequest) (*v1.DeleteNoteTemplateResponse, error) {
+	if err := s.templateUsecase.DeleteTemplate(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to delete note template")
+	}
+
+	return &v1.DeleteNoteTemplateResponse{}, nil
+}
+
+// ListNoteTemplates はListNoteTemplates RPCメソッドを実装します
+func (s *server) ListNoteTemplates(ctx context.Context, req *v1.ListNoteTemplatesRequest) (*v1.ListNoteTemplatesResponse, error) {
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, next, err := s.templateUsecase.ListTemplates(ctx, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list note templates")
+	}
+
+	resp := &v1.ListNoteTemplatesResponse{NextPageToken: encodePageToken(next)}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toProtoTemplate(t))
+	}
+	return resp, nil
+}
+
+// CreateNoteFromTemplate はCreateNoteFromTemplate RPCメソッドを実装します
+func (s *server) CreateNoteFromTemplate(ctx context.Context, req *v1.CreateNoteFromTemplateRequest) (*v1.CreateNoteFromTemplateResponse, error) {
+	key, err := idempotencyKey(ctx, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	note, err := s.templateUsecase.CreateNoteFromTemplate(ctx, req.TemplateId, req.Variables, req.Tags, req.NotebookId, key)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create note from template")
+	}
+
+	return &v1.CreateNoteFromTemplateResponse{Note: toProtoNote(note)}, nil
+}
+
+// toProtoTemplate はドメインのテンプレートをprotobufメッセージに変換します
+func toProtoTemplate(t *domain.NoteTemplate) *v1.NoteTemplate {
+	return &v1.NoteTemplate{
+		Id:              t.ID,
+		Name:            t.Name,
+		TitleTemplate:   t.TitleTemplate,
+		ContentTemplate: t.ContentTemplate,
+		CreatedAt:       timestamppb.New(t.CreatedAt),
+		UpdatedAt:       timestamppb.New(t.UpdatedAt),
+	}
+}
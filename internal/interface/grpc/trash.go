@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeleteNote はDeleteNote RPCメソッドを実装します
+func (s *server) DeleteNote(ctx context.Context, req *v1.DeleteNoteRequest) (*v1.DeleteNoteResponse, error) {
+	var expectedVersion int64
+	if req.ExpectedVersion > 0 || req.Etag != "" {
+		var err error
+		expectedVersion, err = expectedNoteVersion(req.ExpectedVersion, req.Etag)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	note, err := s.noteUsecase.DeleteNote(ctx, req.Id, expectedVersion)
+	if err != nil {
+		return nil, toStatusError(err, "failed to delete note")
+	}
+
+	return &v1.DeleteNoteResponse{Note: toProtoNote(note)}, nil
+}
+
+// ListTrash はListTrash RPCメソッドを実装します
+func (s *server) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	beforeID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, next, err := s.trashUsecase.ListTrash(ctx, int(req.PageSize), beforeID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list trash")
+	}
+
+	resp := &v1.ListTrashResponse{NextPageToken: encodePageToken(next)}
+	for _, note := range notes {
+		resp.Notes = append(resp.Notes, toProtoNote(note))
+	}
+	return resp, nil
+}
+
+// RestoreNote はRestoreNote RPCメソッドを実装します
+func (s *server) RestoreNote(ctx context.Context, req *v1.RestoreNoteRequest) (*v1.RestoreNoteResponse, error) {
+	note, err := s.trashUsecase.RestoreNote(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to restore note")
+	}
+
+	return &v1.RestoreNoteResponse{Note: toProtoNote(note)}, nil
+}
+
+// PurgeNote はPurgeNote RPCメソッドを実装します
+func (s *server) PurgeNote(ctx context.Context, req *v1.PurgeNoteRequest) (*v1.PurgeNoteResponse, error) {
+	if err := s.trashUsecase.PurgeNote(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to purge note")
+	}
+
+	return &v1.PurgeNoteResponse{}, nil
+}
+
+// toProtoNote はドメインのノートをprotobufメッセージに変換します
+func toProtoNote(note *domain.Note) *v1.Note {
+	pb := &v1.Note{
+		Id:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		Version:    note.Version,
+		Etag:       noteETag(note),
+		Tags:       note.Tags,
+		NotebookId: note.NotebookID,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
+	}
+	if note.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*note.DeletedAt)
+	}
+	return pb
+}
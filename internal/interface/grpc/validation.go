@@ -0,0 +1,247 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// patternCache はコンパイル済みの正規表現をパターン文字列ごとに保持します
+var patternCache sync.Map
+
+// validationUnaryInterceptor はリクエストメッセージを(rules)アノテーションに従って検証します
+func validationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// validationStreamInterceptor はストリームで受信する各メッセージを検証します
+func validationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+// validatingServerStream は受信メッセージを検証するgrpc.ServerStreamです
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg はメッセージを受信して検証します
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+// validateRequest はメッセージを検証し、違反がある場合はBadRequest詳細付きのInvalidArgumentを返します
+func validateRequest(req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descs = append(descs, v.Field+": "+v.Description)
+	}
+	return badRequestStatus("validation failed: "+strings.Join(descs, "; "), violations)
+}
+
+// validateMessage はメッセージの全フィールドを再帰的に検証します
+func validateMessage(m protoreflect.Message, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		rules, _ := proto.GetExtension(fd.Options(), v1.E_Rules).(*v1.FieldRules)
+		report := func(field, description string) {
+			*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+		}
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if rules != nil {
+				validateList(fd, list, rules, path, report)
+			}
+			if fd.Kind() == protoreflect.MessageKind {
+				for j := 0; j < list.Len(); j++ {
+					validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+				}
+			}
+		case fd.IsMap():
+			// マップフィールドは現在検証対象外です
+		default:
+			if rules != nil {
+				validateValue(fd, m.Has(fd), m.Get(fd), rules, path, report)
+			}
+			if fd.Kind() == protoreflect.MessageKind && m.Has(fd) {
+				validateMessage(m.Get(fd).Message(), path+".", violations)
+			}
+		}
+	}
+}
+
+// validateList は繰り返しフィールドの件数・一意性と各要素を検証します
+func validateList(fd protoreflect.FieldDescriptor, list protoreflect.List, rules *v1.FieldRules, path string, report func(field, description string)) {
+	if rules.GetRequired() && list.Len() == 0 {
+		report(path, "is required")
+	}
+
+	r := rules.GetRepeated()
+	if r == nil {
+		return
+	}
+
+	n := uint64(list.Len())
+	if r.MinItems != nil && n < r.GetMinItems() {
+		report(path, fmt.Sprintf("must contain at least %d items", r.GetMinItems()))
+	}
+	if r.MaxItems != nil && n > r.GetMaxItems() {
+		report(path, fmt.Sprintf("must contain at most %d items", r.GetMaxItems()))
+	}
+	if r.GetUnique() && fd.Kind() != protoreflect.MessageKind {
+		seen := make(map[interface{}]bool, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			key := list.Get(i).Interface()
+			if b, ok := key.([]byte); ok {
+				key = string(b)
+			}
+			if seen[key] {
+				report(path, "must contain unique items")
+				break
+			}
+			seen[key] = true
+		}
+	}
+	if items := r.GetItems(); items != nil {
+		for i := 0; i < list.Len(); i++ {
+			validateValue(fd, true, list.Get(i), items, fmt.Sprintf("%s[%d]", path, i), report)
+		}
+	}
+}
+
+// validateValue は単一の値を型ごとのルールで検証します
+func validateValue(fd protoreflect.FieldDescriptor, has bool, value protoreflect.Value, rules *v1.FieldRules, path string, report func(field, description string)) {
+	if rules.GetRequired() && !has {
+		report(path, "is required")
+		return
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		validateString(value.String(), rules.GetString_(), path, report)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if r := rules.GetInt32(); r != nil {
+			validateInt(int64(value.Int()), int32Bounds(r), path, report)
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if r := rules.GetInt64(); r != nil {
+			validateInt(value.Int(), int64Bounds(r), path, report)
+		}
+	case protoreflect.EnumKind:
+		if r := rules.GetEnum(); r != nil && r.GetDefinedOnly() {
+			if fd.Enum().Values().ByNumber(value.Enum()) == nil {
+				report(path, "must be a defined enum value")
+			}
+		}
+	}
+}
+
+// validateString は文字列ルールを検証します
+func validateString(s string, r *v1.StringRules, path string, report func(field, description string)) {
+	if r == nil {
+		return
+	}
+
+	n := uint64(utf8.RuneCountInString(s))
+	if r.MinLen != nil && n < r.GetMinLen() {
+		if r.GetMinLen() == 1 {
+			report(path, "is required")
+		} else {
+			report(path, fmt.Sprintf("must be at least %d characters", r.GetMinLen()))
+		}
+	}
+	if r.MaxLen != nil && n > r.GetMaxLen() {
+		report(path, fmt.Sprintf("must be at most %d characters", r.GetMaxLen()))
+	}
+	if r.MaxBytes != nil && uint64(len(s)) > r.GetMaxBytes() {
+		report(path, fmt.Sprintf("must be at most %d bytes", r.GetMaxBytes()))
+	}
+	if r.Pattern != nil && s != "" {
+		re, err := compilePattern(r.GetPattern())
+		if err != nil {
+			report(path, fmt.Sprintf("has an invalid validation pattern: %v", err))
+		} else if !re.MatchString(s) {
+			report(path, fmt.Sprintf("must match pattern %q", r.GetPattern()))
+		}
+	}
+}
+
+// intBounds は整数ルールの境界値を表します
+type intBounds struct {
+	gt, gte, lt, lte *int64
+}
+
+func int32Bounds(r *v1.Int32Rules) intBounds {
+	widen := func(v *int32) *int64 {
+		if v == nil {
+			return nil
+		}
+		w := int64(*v)
+		return &w
+	}
+	return intBounds{gt: widen(r.Gt), gte: widen(r.Gte), lt: widen(r.Lt), lte: widen(r.Lte)}
+}
+
+func int64Bounds(r *v1.Int64Rules) intBounds {
+	return intBounds{gt: r.Gt, gte: r.Gte, lt: r.Lt, lte: r.Lte}
+}
+
+// validateInt は整数の範囲を検証します
+func validateInt(v int64, b intBounds, path string, report func(field, description string)) {
+	if b.gt != nil && v <= *b.gt {
+		report(path, fmt.Sprintf("must be greater than %d", *b.gt))
+	}
+	if b.gte != nil && v < *b.gte {
+		report(path, fmt.Sprintf("must be greater than or equal to %d", *b.gte))
+	}
+	if b.lt != nil && v >= *b.lt {
+		report(path, fmt.Sprintf("must be less than %d", *b.lt))
+	}
+	if b.lte != nil && v > *b.lte {
+		report(path, fmt.Sprintf("must be less than or equal to %d", *b.lte))
+	}
+}
+
+// compilePattern は正規表現をコンパイルしてキャッシュします
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patternCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patternCache.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,282 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// violatedFields はエラーのBadRequest詳細から違反したフィールド名を取り出します
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+// checkViolations はerrがwantFieldsの違反だけを報告していることを確認します
+func checkViolations(t *testing.T, err error, wantFields []string) {
+	t.Helper()
+	if len(wantFields) == 0 {
+		if err != nil {
+			t.Fatalf("error = %v, want nil", err)
+		}
+		return
+	}
+	got := violatedFields(t, err)
+	if strings.Join(got, ",") != strings.Join(wantFields, ",") {
+		t.Errorf("violated fields = %v, want %v (error: %v)", got, wantFields, err)
+	}
+}
+
+func TestValidationUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        proto.Message
+		wantFields []string
+	}{
+		{
+			name: "valid create",
+			req:  &v1.CreateNoteRequest{Title: "title", Content: "content", IdempotencyKey: "key-1", Tags: []string{"go"}},
+		},
+		{
+			name:       "string min_len",
+			req:        &v1.CreateNoteRequest{Title: "", Content: "content"},
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "string max_len counts characters",
+			req:        &v1.CreateNoteRequest{Title: strings.Repeat("あ", 256), Content: "content"},
+			wantFields: []string{"title"},
+		},
+		{
+			name: "string max_len at the limit",
+			req:  &v1.CreateNoteRequest{Title: strings.Repeat("あ", 255), Content: "content"},
+		},
+		{
+			name:       "string max_bytes",
+			req:        &v1.CreateNoteRequest{Title: "title", Content: strings.Repeat("a", 65536)},
+			wantFields: []string{"content"},
+		},
+		{
+			name:       "string pattern",
+			req:        &v1.CreateNoteRequest{Title: "title", Content: "content", IdempotencyKey: "key with spaces"},
+			wantFields: []string{"idempotency_key"},
+		},
+		{
+			name:       "int64 gt",
+			req:        &v1.GetNoteRequest{Id: 0},
+			wantFields: []string{"id"},
+		},
+		{
+			name: "int64 gt satisfied",
+			req:  &v1.GetNoteRequest{Id: 1},
+		},
+		{
+			name:       "int64 gte",
+			req:        &v1.CreateNoteRequest{Title: "title", Content: "content", NotebookId: -1},
+			wantFields: []string{"notebook_id"},
+		},
+		{
+			name:       "int32 lte",
+			req:        &v1.ListNotesRequest{PageSize: 101},
+			wantFields: []string{"page_size"},
+		},
+		{
+			name:       "repeated min_items",
+			req:        &v1.BatchGetNotesRequest{},
+			wantFields: []string{"ids"},
+		},
+		{
+			name:       "repeated max_items",
+			req:        &v1.BatchGetNotesRequest{Ids: make101IDs()},
+			wantFields: []string{"ids"},
+		},
+		{
+			name:       "repeated items",
+			req:        &v1.BatchGetNotesRequest{Ids: []int64{1, 0, 3, -4}},
+			wantFields: []string{"ids[1]", "ids[3]"},
+		},
+		{
+			name:       "repeated string items",
+			req:        &v1.CreateNoteRequest{Title: "title", Content: "content", Tags: []string{"go", ""}},
+			wantFields: []string{"tags[1]"},
+		},
+		{
+			name:       "repeated unique",
+			req:        &v1.WatchNotesRequest{NoteIds: []int64{1, 2, 1}},
+			wantFields: []string{"note_ids"},
+		},
+		{
+			name: "enum defined",
+			req:  &v1.CreateWebhookRequest{Url: "https://example.com", Secret: "0123456789abcdef", EventTypes: []v1.NoteEventType{v1.NoteEventType_NOTE_EVENT_TYPE_CREATED}},
+		},
+		{
+			name:       "enum defined_only",
+			req:        &v1.CreateWebhookRequest{Url: "https://example.com", Secret: "0123456789abcdef", EventTypes: []v1.NoteEventType{99}},
+			wantFields: []string{"event_types[0]"},
+		},
+		{
+			name:       "multiple violations",
+			req:        &v1.UpdateNoteRequest{Id: 0, Title: "", Content: "content", ExpectedVersion: -1},
+			wantFields: []string{"id", "title", "expected_version"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return req, nil
+			}
+			_, err := validationUnaryInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+			checkViolations(t, err, tt.wantFields)
+			// 違反がある場合はハンドラを呼び出しません
+			if called != (len(tt.wantFields) == 0) {
+				t.Errorf("handler called = %v, want %v", called, len(tt.wantFields) == 0)
+			}
+		})
+	}
+}
+
+func make101IDs() []int64 {
+	ids := make([]int64, 101)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	return ids
+}
+
+// fakeServerStream は用意したメッセージを順に受信するgrpc.ServerStreamです
+type fakeServerStream struct {
+	grpc.ServerStream
+	msgs []proto.Message
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.msgs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.msgs[0])
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	validNote := &v1.BulkCreateNotesRequest{Payload: &v1.BulkCreateNotesRequest_Note{Note: &v1.BulkCreateNoteItem{Title: "title", Content: "content"}}}
+
+	tests := []struct {
+		name       string
+		msgs       []proto.Message
+		wantFields []string
+		// wantReceived は検証エラーまでに受信できたメッセージ数です
+		wantReceived int
+	}{
+		{
+			name: "valid stream",
+			msgs: []proto.Message{
+				&v1.BulkCreateNotesRequest{Payload: &v1.BulkCreateNotesRequest_Options{Options: &v1.BulkCreateNotesOptions{Mode: v1.BulkCreateMode_BULK_CREATE_MODE_ALL_OR_NOTHING}}},
+				validNote,
+			},
+			wantReceived: 2,
+		},
+		{
+			name: "enum defined_only in a nested message",
+			msgs: []proto.Message{
+				&v1.BulkCreateNotesRequest{Payload: &v1.BulkCreateNotesRequest_Options{Options: &v1.BulkCreateNotesOptions{Mode: 42}}},
+				validNote,
+			},
+			wantFields:   []string{"options.mode"},
+			wantReceived: 0,
+		},
+		{
+			name: "violation in a later message",
+			msgs: []proto.Message{
+				&v1.ImportNotesRequest{Payload: &v1.ImportNotesRequest_Options{Options: &v1.ImportOptions{}}},
+				&v1.ImportNotesRequest{Payload: &v1.ImportNotesRequest_Options{Options: &v1.ImportOptions{NotebookId: -1}}},
+			},
+			wantFields:   []string{"options.notebook_id"},
+			wantReceived: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := 0
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				for {
+					m := proto.Clone(tt.msgs[0])
+					proto.Reset(m)
+					if err := stream.RecvMsg(m); err != nil {
+						if errors.Is(err, io.EOF) {
+							return nil
+						}
+						return err
+					}
+					received++
+				}
+			}
+			err := validationStreamInterceptor(nil, &fakeServerStream{msgs: tt.msgs}, &grpc.StreamServerInfo{FullMethod: "/test"}, handler)
+			checkViolations(t, err, tt.wantFields)
+			if received != tt.wantReceived {
+				t.Errorf("received = %d, want %d", received, tt.wantReceived)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKeyMetadata(t *testing.T) {
+	tests := []struct {
+		name       string
+		field      string
+		metadata   []string
+		want       string
+		wantFields []string
+	}{
+		{name: "none", want: ""},
+		{name: "field wins", field: "field-key", metadata: []string{"md-key"}, want: "field-key"},
+		{name: "valid metadata", metadata: []string{"order-42:v1"}, want: "order-42:v1"},
+		{name: "too long", metadata: []string{strings.Repeat("k", 129)}, wantFields: []string{"metadata.idempotency-key"}},
+		{name: "invalid characters", metadata: []string{"key with spaces"}, wantFields: []string{"metadata.idempotency-key"}},
+		{name: "glob characters", metadata: []string{"a*b"}, wantFields: []string{"metadata.idempotency-key"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", tt.metadata[0]))
+			}
+			got, err := idempotencyKey(ctx, tt.field)
+			checkViolations(t, err, tt.wantFields)
+			if got != tt.want {
+				t.Errorf("idempotencyKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// noteEventTypes はドメインのイベントの種類とprotoの列挙値の対応です
+var noteEventTypes = map[domain.NoteEventType]v1.NoteEventType{
+	domain.NoteCreated:  v1.NoteEventType_NOTE_EVENT_TYPE_CREATED,
+	domain.NoteUpdated:  v1.NoteEventType_NOTE_EVENT_TYPE_UPDATED,
+	domain.NoteDeleted:  v1.NoteEventType_NOTE_EVENT_TYPE_DELETED,
+	domain.NoteRestored: v1.NoteEventType_NOTE_EVENT_TYPE_RESTORED,
+	domain.NotePurged:   v1.NoteEventType_NOTE_EVENT_TYPE_PURGED,
+}
+
+// WatchNotes はWatchNotes RPCメソッドを実装します
+func (s *server) WatchNotes(req *v1.WatchNotesRequest, stream grpc.ServerStreamingServer[v1.NoteEvent]) error {
+	ctx := stream.Context()
+	err := s.watchUsecase.WatchNotes(ctx, req.NoteIds, req.AfterEventId, func(event *domain.NoteEvent) error {
+		return stream.Send(toProtoNoteEvent(event))
+	})
+	if ctx.Err() != nil {
+		// クライアントの切断やサーバーの停止による終了です
+		return ctx.Err()
+	}
+	return toStatusError(err, "failed to watch notes")
+}
+
+// toProtoNoteEvent はドメインのイベントをprotoメッセージに変換します
+func toProtoNoteEvent(e *domain.NoteEvent) *v1.NoteEvent {
+	return &v1.NoteEvent{
+		Id:         e.ID,
+		Type:       noteEventTypes[e.Type],
+		NoteId:     e.NoteID,
+		Version:    e.Version,
+		OccurredAt: timestamppb.New(e.OccurredAt),
+	}
+}
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"go_test/internal/domain"
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// webhookDeliveryStatuses はドメインの送信状況とprotoの列挙値の対応です
+var webhookDeliveryStatuses = map[domain.WebhookDeliveryStatus]v1.WebhookDeliveryStatus{
+	domain.WebhookDeliveryPending:   v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	domain.WebhookDeliverySucceeded: v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+	domain.WebhookDeliveryFailed:    v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED,
+}
+
+// CreateWebhook はCreateWebhook RPCメソッドを実装します
+func (s *server) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookResponse, error) {
+	w, err := s.webhookUsecase.CreateWebhook(ctx, req.Url, fromProtoNoteEventTypes(req.EventTypes), req.Secret)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create webhook")
+	}
+
+	return &v1.CreateWebhookResponse{Webhook: toProtoWebhook(w)}, nil
+}
+
+// GetWebhook はGetWebhook RPCメソッドを実装します
+func (s *server) GetWebhook(ctx context.Context, req *v1.GetWebhookRequest) (*v1.GetWebhookResponse, error) {
+	w, err := s.webhookUsecase.GetWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get webhook")
+	}
+
+	return &v1.GetWebhookResponse{Webhook: toProtoWebhook(w)}, nil
+}
+
+// UpdateWebhook はUpdateWebhook RPCメソッドを実装します
+func (s *server) UpdateWebhook(ctx context.Context, req *v1.UpdateWebhookRequest) (*v1.UpdateWebhookResponse, error) {
+	w, err := s.webhookUsecase.UpdateWebhook(ctx, req.Id, req.Url, fromProtoNoteEventTypes(req.EventTypes), req.Secret, req.Active)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update webhook")
+	}
+
+	return &v1.UpdateWebhookResponse{Webhook: toProtoWebhook(w)}, nil
+}
+
+// DeleteWebhook はDeleteWebhook RPCメソッドを実装します
+func (s *server) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*v1.DeleteWebhookResponse, error) {
+	if err := s.webhookUsecase.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to delete webhook")
+	}
+
+	return &v1.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhooks はListWebhooks RPCメソッドを実装します
+func (s *server) ListWebhooks(ctx context.Context, req *v1.ListWebhooksRequest) (*v1.ListWebhooksResponse, error) {
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, next, err := s.webhookUsecase.ListWebhooks(ctx, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list webhooks")
+	}
+
+	resp := &v1.ListWebhooksResponse{NextPageToken: encodePageToken(next)}
+	for _, w := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, toProtoWebhook(w))
+	}
+	return resp, nil
+}
+
+// ListWebhookDeliveries はListWebhookDeliveries RPCメソッドを実装します
+func (s *server) ListWebhookDeliveries(ctx context.Context, req *v1.ListWebhookDeliveriesRequest) (*v1.ListWebhookDeliveriesResponse, error) {
+	beforeID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, next, err := s.webhookUsecase.ListWebhookDeliveries(ctx, req.WebhookId, int(req.PageSize), beforeID)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list webhook deliveries")
+	}
+
+	resp := &v1.ListWebhookDeliveriesResponse{NextPageToken: encodePageToken(next)}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toProtoWebhookDelivery(d))
+	}
+	return resp, nil
+}
+
+// fromProtoNoteEventTypes はprotoのイベントの種類をドメインのイベントの種類に変換します
+// 対応しない値（UNSPECIFIED）は空の種類となり、ドメインの検証で拒否されます
+func fromProtoNoteEventTypes(types []v1.NoteEventType) []domain.NoteEventType {
+	if len(types) == 0 {
+		return nil
+	}
+	result := make([]domain.NoteEventType, len(types))
+	for i, t := range types {
+		for eventType, protoType := range noteEventTypes {
+			if protoType == t {
+				result[i] = eventType
+				break
+			}
+		}
+	}
+	return result
+}
+
+// toProtoWebhook はドメインのWebhookをprotoメッセージに変換します。シークレットは含めません
+func toProtoWebhook(w *domain.Webhook) *v1.Webhook {
+	pw := &v1.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Active:    w.Active,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+		UpdatedAt: timestamppb.New(w.UpdatedAt),
+	}
+	for _, t := range w.EventTypes {
+		pw.EventTypes = append(pw.EventTypes, noteEventTypes[t])
+	}
+	return pw
+}
+
+// toProtoWebhookDelivery はドメインの送信記録をprotoメッセージに変換します
+func toProtoWebhookDelivery(d *domain.WebhookDelivery) *v1.WebhookDelivery {
+	pd := &v1.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.WebhookID,
+		Event:          toProtoNoteEvent(&d.Event),
+		Status:         webhookDeliveryStatuses[d.Status],
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == domain.WebhookDeliveryPending {
+		pd.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.LastAttemptAt != nil {
+		pd.LastAttemptAt = timestamppb.New(*d.LastAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		pd.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return pd
+}
@@ -0,0 +1,52 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"go_test/internal/usecase"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// renderer はMarkdownRendererインターフェースを実装します
+type renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+// NewRenderer はCommonMarkとGFM（表、打ち消し線、自動リンク、タスクリスト）に対応したレンダラーを作成します
+// 本文中のHTMLもそのまま出力したうえで、許可リストに含まれる要素と属性以外をすべて取り除きます
+func NewRenderer() usecase.MarkdownRenderer {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	return &renderer{md: md, policy: newPolicy()}
+}
+
+// newPolicy はノートのHTMLに許可する要素と属性の一覧を作成します
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// コードブロックの言語指定
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+	// GFMのタスクリスト
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	// 外部リンクは新しいタブで開き、参照元を渡しません
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Render はMarkdownをサニタイズ済みのHTMLに変換します
+func (r *renderer) Render(content string) (string, error) {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(content), &buf); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	return r.policy.Sanitize(buf.String()), nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// maxRowsPerInsert は複数行INSERTの1文あたりの最大行数です
+// プレースホルダーの上限（65535）と1文の大きさ（max_allowed_packet）を超えないようにします
+const maxRowsPerInsert = 500
+
+// insertRows はrowsをmaxRowsPerInsert行ずつの複数行INSERTで挿入します
+// prefixは"INSERT INTO t (a, b) VALUES"のようなVALUESまでの文で、各行の値の数はすべて同じである必要があります
+func insertRows(ctx context.Context, tx *sql.Tx, prefix string, rows [][]interface{}) error {
+	for start := 0; start < len(rows); start += maxRowsPerInsert {
+		end := min(start+maxRowsPerInsert, len(rows))
+		chunk := rows[start:end]
+
+		row := "(" + placeholders(len(chunk[0])) + ")"
+		values := strings.TrimSuffix(strings.Repeat(row+", ", len(chunk)), ", ")
+		args := make([]interface{}, 0, len(chunk)*len(chunk[0]))
+		for _, r := range chunk {
+			args = append(args, r...)
+		}
+
+		if _, err := tx.ExecContext(ctx, prefix+" "+values, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idsArgs はIDの一覧をクエリの引数に変換します
+func idsArgs(ids []int64) []interface{} {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return args
+}
+
+// autoIncrementIncrement は複数行INSERTで割り当てられるAUTO_INCREMENTの値の間隔を返します
+func autoIncrementIncrement(ctx context.Context, tx *sql.Tx) (int64, error) {
+	var increment int64
+	if err := tx.QueryRowContext(ctx, `SELECT @@SESSION.auto_increment_increment`).Scan(&increment); err != nil {
+		return 0, fmt.Errorf("failed to get auto_increment_increment: %w", err)
+	}
+	return increment, nil
+}
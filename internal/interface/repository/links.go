@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"strings"
+)
+
+// replaceLinks はノートの本文から取り出したリンクで発リンクを置き換えます
+func replaceLinks(ctx context.Context, tx *sql.Tx, noteID int64, links []domain.WikiLink) error {
+	if err := deleteLinks(ctx, tx, noteID); err != nil {
+		return err
+	}
+	if len(links) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(links)*3)
+	for _, link := range links {
+		var title interface{}
+		if link.NoteID == 0 {
+			title = link.Title
+		}
+		args = append(args, noteID, nullableID(link.NoteID), title)
+	}
+
+	values := strings.TrimSuffix(strings.Repeat("(?, ?, ?), ", len(links)), ", ")
+	query := `INSERT INTO note_links (source_note_id, target_note_id, target_title) VALUES ` + values
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert note links: %w", err)
+	}
+	return nil
+}
+
+// insertLinks は作成したばかりのノートの一覧の発リンクを記録します
+func insertLinks(ctx context.Context, tx *sql.Tx, notes []*domain.Note) error {
+	var rows [][]interface{}
+	for _, note := range notes {
+		for _, link := range note.Links() {
+			var title interface{}
+			if link.NoteID == 0 {
+				title = link.Title
+			}
+			rows = append(rows, []interface{}{note.ID, nullableID(link.NoteID), title})
+		}
+	}
+	if err := insertRows(ctx, tx, `INSERT INTO note_links (source_note_id, target_note_id, target_title) VALUES`, rows); err != nil {
+		return fmt.Errorf("failed to insert note links: %w", err)
+	}
+	return nil
+}
+
+// deleteLinks はノートの発リンクをすべて削除します
+func deleteLinks(ctx context.Context, tx *sql.Tx, noteID int64) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM note_links WHERE source_note_id = ?`, noteID); err != nil {
+		return fmt.Errorf("failed to delete note links: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// attachmentColumns は添付ファイルを取得する際のカラム一覧です（scanAttachmentと順序を合わせます）
+const attachmentColumns = `id, note_id, filename, content_type, size, sha256, created_at`
+
+// mysqlAttachmentRepository はAttachmentRepositoryインターフェースを実装します
+type mysqlAttachmentRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLAttachmentRepository は新しいMySQL添付ファイルリポジトリを作成します
+func NewMySQLAttachmentRepository(db *sql.DB) usecase.AttachmentRepository {
+	return &mysqlAttachmentRepository{db: db}
+}
+
+// scanAttachment はattachmentColumnsの順で1行を読み取ります
+func scanAttachment(row rowScanner) (*domain.Attachment, error) {
+	var a domain.Attachment
+	if err := row.Scan(&a.ID, &a.NoteID, &a.Filename, &a.ContentType, &a.Size, &a.SHA256, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Create はデータベースに添付ファイルのメタデータを作成します
+func (r *mysqlAttachmentRepository) Create(ctx context.Context, a *domain.Attachment) (*domain.Attachment, error) {
+	query := `INSERT INTO attachments (note_id, filename, content_type, size, sha256) VALUES (?, ?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, a.NoteID, a.Filename, a.ContentType, a.Size, a.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return r.Get(ctx, a.NoteID, id)
+}
+
+// Get はノートの添付ファイルをIDで取得します
+func (r *mysqlAttachmentRepository) Get(ctx context.Context, noteID, id int64) (*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE note_id = ? AND id = ?`
+	a, err := scanAttachment(r.db.QueryRowContext(ctx, query, noteID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("attachment with id %d: %w", id, domain.ErrAttachmentNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan attachment: %w", err)
+	}
+	return a, nil
+}
+
+// ListByNote はノートの添付ファイルをIDの昇順で取得します
+func (r *mysqlAttachmentRepository) ListByNote(ctx context.Context, noteID int64) ([]*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE note_id = ? ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// Delete は添付ファイルのメタデータを削除します
+func (r *mysqlAttachmentRepository) Delete(ctx context.Context, noteID, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM attachments WHERE note_id = ? AND id = ?`, noteID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("attachment with id %d: %w", id, domain.ErrAttachmentNotFound)
+	}
+	return nil
+}
+
+// ReferencedDigests はdigestsのうちいずれかの添付ファイルから参照されているものを返します
+func (r *mysqlAttachmentRepository) ReferencedDigests(ctx context.Context, digests []string) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+	if len(digests) == 0 {
+		return referenced, nil
+	}
+
+	args := make([]interface{}, len(digests))
+	for i, d := range digests {
+		args[i] = d
+	}
+	query := `SELECT DISTINCT sha256 FROM attachments WHERE sha256 IN (` + placeholders(len(digests)) + `)`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachment digests: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var digest string
+		if err := rows.Scan(&digest); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment digest: %w", err)
+		}
+		referenced[digest] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate attachment digests: %w", err)
+	}
+	return referenced, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// commentColumns はコメントを取得する際のカラム一覧です（scanCommentと順序を合わせます）
+// 直接の返信の数を相関サブクエリで数えるため、commentsテーブルにcの別名を付けて使用します
+const commentColumns = `c.id, c.note_id, c.parent_id, c.author, c.body, c.created_at, c.updated_at, c.deleted_at,
+	(SELECT COUNT(*) FROM comments r WHERE r.note_id = c.note_id AND r.parent_id = c.id)`
+
+// mysqlCommentRepository はCommentRepositoryインターフェースを実装します
+type mysqlCommentRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLCommentRepository は新しいMySQLコメントリポジトリを作成します
+func NewMySQLCommentRepository(db *sql.DB) usecase.CommentRepository {
+	return &mysqlCommentRepository{db: db}
+}
+
+// scanComment はcommentColumnsの順で1行を読み取ります
+func scanComment(row rowScanner) (*domain.Comment, error) {
+	var c domain.Comment
+	var parentID sql.NullInt64
+	var deletedAt sql.NullTime
+	if err := row.Scan(&c.ID, &c.NoteID, &parentID, &c.Author, &c.Body, &c.CreatedAt, &c.UpdatedAt, &deletedAt, &c.ReplyCount); err != nil {
+		return nil, err
+	}
+	c.ParentID = parentID.Int64
+	if deletedAt.Valid {
+		c.DeletedAt = &deletedAt.Time
+	}
+	return &c, nil
+}
+
+// Create はデータベースに新しいコメントを作成します
+func (r *mysqlCommentRepository) Create(ctx context.Context, c *domain.Comment) (*domain.Comment, error) {
+	query := `INSERT INTO comments (note_id, parent_id, author, body) VALUES (?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, c.NoteID, nullableID(c.ParentID), c.Author, c.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert comment: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return r.Get(ctx, c.NoteID, id)
+}
+
+// Get はノートのコメントをIDで取得します
+func (r *mysqlCommentRepository) Get(ctx context.Context, noteID, id int64) (*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments c WHERE c.note_id = ? AND c.id = ?`
+	c, err := scanComment(r.db.QueryRowContext(ctx, query, noteID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("comment with id %d: %w", id, domain.ErrCommentNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan comment: %w", err)
+	}
+	return c, nil
+}
+
+// Update はコメントの本文を更新します
+func (r *mysqlCommentRepository) Update(ctx context.Context, c *domain.Comment) (*domain.Comment, error) {
+	query := `UPDATE comments SET body = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE note_id = ? AND id = ? AND deleted_at IS NULL`
+	result, err := r.db.ExecContext(ctx, query, c.Body, c.NoteID, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("comment with id %d: %w", c.ID, domain.ErrCommentNotFound)
+	}
+
+	return r.Get(ctx, c.NoteID, c.ID)
+}
+
+// SoftDelete はコメントの本文を空にして削除日時を設定します
+func (r *mysqlCommentRepository) SoftDelete(ctx context.Context, noteID, id int64) error {
+	query := `UPDATE comments SET body = '', deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE note_id = ? AND id = ? AND deleted_at IS NULL`
+	result, err := r.db.ExecContext(ctx, query, noteID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("comment with id %d: %w", id, domain.ErrCommentNotFound)
+	}
+	return nil
+}
+
+// List はparentIDへの返信をIDの昇順で取得します
+func (r *mysqlCommentRepository) List(ctx context.Context, noteID, parentID int64, limit int, afterID int64) ([]*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments c
+		WHERE c.note_id = ? AND c.parent_id <=> ? AND c.id > ? ORDER BY c.id LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, noteID, nullableID(parentID), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate comments: %w", err)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// mysqlLinkRepository はNoteLinkRepositoryインターフェースを実装します
+type mysqlLinkRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLLinkRepository は新しいMySQLリンクリポジトリを作成します
+func NewMySQLLinkRepository(db *sql.DB) usecase.NoteLinkRepository {
+	return &mysqlLinkRepository{db: db}
+}
+
+// ListBacklinks はIDまたはタイトルでnoteにリンクしているゴミ箱にないノートをIDの降順で取得します
+// タイトルによるリンクはListOutgoingと同じく、タイトルが完全に一致するノートのうち最も古いノートへのリンクとして扱います
+func (r *mysqlLinkRepository) ListBacklinks(ctx context.Context, note *domain.Note, limit int, beforeID int64) ([]*domain.Note, error) {
+	query := `SELECT ` + noteColumns + ` FROM notes
+		WHERE deleted_at IS NULL AND id <> ? AND (? = 0 OR id < ?)
+			AND id IN (
+				SELECT source_note_id FROM note_links
+				WHERE target_note_id = ?
+					OR (target_note_id IS NULL AND target_title = ? COLLATE utf8mb4_bin
+						AND NOT EXISTS (
+							SELECT 1 FROM notes m
+							WHERE m.deleted_at IS NULL AND m.title = ? COLLATE utf8mb4_bin AND m.id < ?)))
+		ORDER BY id DESC LIMIT ?`
+	return selectNotes(ctx, r.db, query, note.ID, beforeID, beforeID, note.ID, note.Title, note.Title, note.ID, limit)
+}
+
+// ListOutgoing はノートの発リンクを本文での出現順に取得し、参照先のノートを解決します
+// タイトルは完全に一致するもの（大文字小文字を区別）を探し、複数ある場合は最も古いノートに解決します
+func (r *mysqlLinkRepository) ListOutgoing(ctx context.Context, noteID int64) ([]*domain.NoteLink, error) {
+	query := `SELECT l.target_note_id, l.target_title, n.id, n.title
+		FROM note_links l
+		LEFT JOIN notes n ON n.id = (
+			SELECT m.id FROM notes m
+			WHERE m.deleted_at IS NULL
+				AND (m.id = l.target_note_id OR (l.target_note_id IS NULL AND m.title = l.target_title COLLATE utf8mb4_bin))
+			ORDER BY m.id LIMIT 1)
+		WHERE l.source_note_id = ?
+		ORDER BY l.id`
+	rows, err := r.db.QueryContext(ctx, query, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query note links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*domain.NoteLink
+	for rows.Next() {
+		var targetNoteID, resolvedID sql.NullInt64
+		var targetTitle, resolvedTitle sql.NullString
+		if err := rows.Scan(&targetNoteID, &targetTitle, &resolvedID, &resolvedTitle); err != nil {
+			return nil, fmt.Errorf("failed to scan note link: %w", err)
+		}
+		links = append(links, &domain.NoteLink{
+			Link:         domain.WikiLink{NoteID: targetNoteID.Int64, Title: targetTitle.String},
+			TargetNoteID: resolvedID.Int64,
+			TargetTitle:  resolvedTitle.String,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate note links: %w", err)
+	}
+
+	return links, nil
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDeadlock はデッドロックによりトランザクションが中断されたことを示すMySQLのエラー番号です
+const mysqlErrDeadlock = 1213
+
+// notebookColumns はノートブックを取得する際のカラム一覧です（scanNotebookと順序を合わせます）
+const notebookColumns = `id, name, parent_id, created_at, updated_at`
+
+// mysqlNotebookRepository はNotebookRepositoryインターフェースを実装します
+type mysqlNotebookRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLNotebookRepository は新しいMySQLノートブックリポジトリを作成します
+func NewMySQLNotebookRepository(db *sql.DB) usecase.NotebookRepository {
+	return &mysqlNotebookRepository{db: db}
+}
+
+// scanNotebook はnotebookColumnsの順で1行を読み取ります
+func scanNotebook(row rowScanner) (*domain.Notebook, error) {
+	var notebook domain.Notebook
+	var parentID sql.NullInt64
+	if err := row.Scan(&notebook.ID, &notebook.Name, &parentID, &notebook.CreatedAt, &notebook.UpdatedAt); err != nil {
+		return nil, err
+	}
+	notebook.ParentID = parentID.Int64
+	return &notebook, nil
+}
+
+// Create はデータベースに新しいノートブックを作成します
+func (r *mysqlNotebookRepository) Create(ctx context.Context, notebook *domain.Notebook) (*domain.Notebook, error) {
+	query := `INSERT INTO notebooks (name, parent_id) VALUES (?, ?)`
+	result, err := r.db.ExecContext(ctx, query, notebook.Name, nullableID(notebook.ParentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert notebook: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID はデータベースからIDでノートブックを取得します
+func (r *mysqlNotebookRepository) GetByID(ctx context.Context, id int64) (*domain.Notebook, error) {
+	query := `SELECT ` + notebookColumns + ` FROM notebooks WHERE id = ?`
+	notebook, err := scanNotebook(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notebook with id %d: %w", id, domain.ErrNotebookNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan notebook: %w", err)
+	}
+	return notebook, nil
+}
+
+// maxNotebookTxAttempts はデッドロックで中断されたトランザクションを再試行する回数です
+const maxNotebookTxAttempts = 3
+
+// Transaction はトランザクション内でfnを呼び出します
+// 互いを移動し合う更新は祖先のロックでデッドロックになるため、中断された場合はやり直して最新の親で再確認させます
+func (r *mysqlNotebookRepository) Transaction(ctx context.Context, fn func(tx usecase.NotebookTx) error) error {
+	var err error
+	for attempt := 1; attempt <= maxNotebookTxAttempts; attempt++ {
+		err = withTx(ctx, r.db, func(tx *sql.Tx) error {
+			return fn(&mysqlNotebookTx{tx: tx})
+		})
+		if !isDeadlock(err) {
+			break
+		}
+	}
+	return err
+}
+
+// mysqlNotebookTx はNotebookTxインターフェースを実装します
+type mysqlNotebookTx struct {
+	tx *sql.Tx
+}
+
+// GetForUpdate はノートブックを行ロックして取得します
+func (t *mysqlNotebookTx) GetForUpdate(ctx context.Context, id int64) (*domain.Notebook, error) {
+	query := `SELECT ` + notebookColumns + ` FROM notebooks WHERE id = ? FOR UPDATE`
+	notebook, err := scanNotebook(t.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notebook with id %d: %w", id, domain.ErrNotebookNotFound)
+		}
+		return nil, fmt.Errorf("failed to lock notebook: %w", err)
+	}
+	return notebook, nil
+}
+
+// LockAncestors はidから最上位まで親をたどり、たどったノートブックを行ロックしてそのIDを返します
+func (t *mysqlNotebookTx) LockAncestors(ctx context.Context, id int64) ([]int64, error) {
+	var ancestors []int64
+	visited := make(map[int64]bool)
+	for current := id; current != 0; {
+		ancestors = append(ancestors, current)
+		if visited[current] {
+			break
+		}
+		visited[current] = true
+
+		var parentID sql.NullInt64
+		err := t.tx.QueryRowContext(ctx, `SELECT parent_id FROM notebooks WHERE id = ? FOR UPDATE`, current).Scan(&parentID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("notebook with id %d: %w", current, domain.ErrNotebookNotFound)
+			}
+			return nil, fmt.Errorf("failed to lock notebook: %w", err)
+		}
+		current = parentID.Int64
+	}
+	return ancestors, nil
+}
+
+// Update はノートブックの名前と親を更新します
+func (t *mysqlNotebookTx) Update(ctx context.Context, notebook *domain.Notebook) error {
+	query := `UPDATE notebooks SET name = ?, parent_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	if _, err := t.tx.ExecContext(ctx, query, notebook.Name, nullableID(notebook.ParentID), notebook.ID); err != nil {
+		return fmt.Errorf("failed to update notebook: %w", err)
+	}
+	return nil
+}
+
+// isDeadlock はMySQLがデッドロックを検出してトランザクションを中断したエラーかを判定します
+func isDeadlock(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDeadlock
+}
+
+// Delete は空のノートブックを削除します
+// 子のノートブックまたはゴミ箱にないノートがある場合はdomain.ErrNotebookNotEmptyを返します
+func (r *mysqlNotebookRepository) Delete(ctx context.Context, id int64) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// 確認中に子やノートが追加されないよう行をロックします
+		var lockedID int64
+		err := tx.QueryRowContext(ctx, `SELECT id FROM notebooks WHERE id = ? FOR UPDATE`, id).Scan(&lockedID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("notebook with id %d: %w", id, domain.ErrNotebookNotFound)
+			}
+			return fmt.Errorf("failed to lock notebook: %w", err)
+		}
+
+		var notEmpty bool
+		query := `SELECT EXISTS (SELECT 1 FROM notebooks WHERE parent_id = ?)
+			OR EXISTS (SELECT 1 FROM notes WHERE notebook_id = ? AND deleted_at IS NULL)`
+		if err := tx.QueryRowContext(ctx, query, id, id).Scan(&notEmpty); err != nil {
+			return fmt.Errorf("failed to check notebook contents: %w", err)
+		}
+		if notEmpty {
+			return fmt.Errorf("notebook with id %d: %w", id, domain.ErrNotebookNotEmpty)
+		}
+
+		// ゴミ箱にあるノートは外部キーによりどのノートブックにも属さない状態になります
+		if _, err := tx.ExecContext(ctx, `DELETE FROM notebooks WHERE id = ?`, id); err != nil {
+			return fmt.Errorf("failed to delete notebook: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListChildren は親がparentIDのノートブックをIDの昇順で取得します。parentIDが0の場合は最上位のノートブックを返します
+func (r *mysqlNotebookRepository) ListChildren(ctx context.Context, parentID int64, limit int, afterID int64) ([]*domain.Notebook, error) {
+	query := `SELECT ` + notebookColumns + ` FROM notebooks
+		WHERE parent_id <=> ? AND id > ?
+		ORDER BY id LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, nullableID(parentID), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notebooks: %w", err)
+	}
+	defer rows.Close()
+
+	var notebooks []*domain.Notebook
+	for rows.Next() {
+		notebook, err := scanNotebook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notebook: %w", err)
+		}
+		notebooks = append(notebooks, notebook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notebooks: %w", err)
+	}
+
+	return notebooks, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+	"time"
+)
+
+// maxOutboxErrorLength は記録する送信エラーの最大バイト数です
+const maxOutboxErrorLength = 1024
+
+// mysqlOutboxRepository はNoteOutboxRepositoryインターフェースを実装します
+type mysqlOutboxRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLOutboxRepository は新しいMySQLアウトボックスリポジトリを作成します
+func NewMySQLOutboxRepository(db *sql.DB) usecase.NoteOutboxRepository {
+	return &mysqlOutboxRepository{db: db}
+}
+
+// ClaimPending は送信予定時刻を過ぎたイベントをロックして取得し、送信予定時刻をlease後に延ばします
+// SKIP LOCKEDにより、複数のレプリカのリレーが同じイベントを同時に取得しないようにします
+func (r *mysqlOutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*usecase.OutboxEntry, error) {
+	var entries []*usecase.OutboxEntry
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `SELECT id, event_type, note_id, version, occurred_at, attempts FROM note_outbox
+			WHERE dead_lettered_at IS NULL AND next_attempt_at <= CURRENT_TIMESTAMP(6)
+			ORDER BY id LIMIT ? FOR UPDATE SKIP LOCKED`
+		rows, err := tx.QueryContext(ctx, query, limit)
+		if err != nil {
+			return fmt.Errorf("failed to query note outbox: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var eventType string
+			event := &domain.NoteEvent{}
+			entry := &usecase.OutboxEntry{Event: event}
+			if err := rows.Scan(&entry.ID, &eventType, &event.NoteID, &event.Version, &event.OccurredAt, &entry.Attempts); err != nil {
+				return fmt.Errorf("failed to scan note outbox event: %w", err)
+			}
+			event.Type = domain.NoteEventType(eventType)
+			entries = append(entries, entry)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate note outbox: %w", err)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		args := make([]interface{}, 0, len(entries)+1)
+		args = append(args, lease.Microseconds())
+		for _, e := range entries {
+			args = append(args, e.ID)
+		}
+		update := `UPDATE note_outbox SET next_attempt_at = CURRENT_TIMESTAMP(6) + INTERVAL ? MICROSECOND
+			WHERE id IN (` + placeholders(len(entries)) + `)`
+		if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+			return fmt.Errorf("failed to lease note outbox events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// MarkPublished は送信済みのイベントを削除します
+func (r *mysqlOutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM note_outbox WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete note outbox event: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed は失敗回数と原因を記録し、送信予定時刻をretryAfter後にします
+func (r *mysqlOutboxRepository) MarkFailed(ctx context.Context, id int64, cause string, retryAfter time.Duration) error {
+	query := `UPDATE note_outbox SET attempts = attempts + 1, last_error = ?,
+		next_attempt_at = CURRENT_TIMESTAMP(6) + INTERVAL ? MICROSECOND WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, truncateError(cause), retryAfter.Microseconds(), id); err != nil {
+		return fmt.Errorf("failed to record note outbox failure: %w", err)
+	}
+	return nil
+}
+
+// MarkDeadLettered は失敗回数と原因を記録し、以降は取得されないようにします
+func (r *mysqlOutboxRepository) MarkDeadLettered(ctx context.Context, id int64, cause string) error {
+	query := `UPDATE note_outbox SET attempts = attempts + 1, last_error = ?, dead_lettered_at = CURRENT_TIMESTAMP(6) WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, truncateError(cause), id); err != nil {
+		return fmt.Errorf("failed to dead-letter note outbox event: %w", err)
+	}
+	return nil
+}
+
+// truncateError はエラーメッセージを記録できる長さに切り詰めます
+func truncateError(msg string) string {
+	if len(msg) <= maxOutboxErrorLength {
+		return msg
+	}
+	// UTF-8の文字の途中で切らないようにします
+	cut := maxOutboxErrorLength
+	for cut > 0 && msg[cut]&0xC0 == 0x80 {
+		cut--
+	}
+	return msg[:cut]
+}
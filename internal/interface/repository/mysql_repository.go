@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"go_test/internal/domain"
 	"go_test/internal/usecase"
+	"strings"
+	"time"
 )
 
 // mysqlRepository はNoteRepositoryインターフェースを実装します
@@ -18,36 +20,693 @@ func NewMySQLRepository(db *sql.DB) usecase.NoteRepository {
 	return &mysqlRepository{db: db}
 }
 
-// Create はデータベースに新しいノートを作成します
+// Create はデータベースに新しいノートと最初のリビジョンを作成し、作成イベントを送信待ちとして記録します
 func (r *mysqlRepository) Create(ctx context.Context, note *domain.Note) (*domain.Note, error) {
-	query := `INSERT INTO notes (title, content) VALUES (?, ?)`
-	result, err := r.db.ExecContext(ctx, query, note.Title, note.Content)
+	var id int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `INSERT INTO notes (title, content, notebook_id) VALUES (?, ?, ?)`
+		result, err := tx.ExecContext(ctx, query, note.Title, note.Content, nullableID(note.NotebookID))
+		if err != nil {
+			return fmt.Errorf("failed to insert note: %w", err)
+		}
+
+		id, err = result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+
+		if err := replaceTags(ctx, tx, id, note.Tags); err != nil {
+			return err
+		}
+		if err := replaceLinks(ctx, tx, id, note.Links()); err != nil {
+			return err
+		}
+		if err := insertRevision(ctx, tx, id); err != nil {
+			return err
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteCreated, id)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert note: %w", err)
+		return nil, err
 	}
 
-	id, err := result.LastInsertId()
+	// データベースで設定されたタイムスタンプとバージョンを反映するため再取得します
+	return r.GetByID(ctx, id)
+}
+
+// BulkCreate は複数のノートを1つのトランザクションで作成し、作成したノートを同じ順序で返します
+// ノート・タグ・リンク・リビジョン・変更イベントはそれぞれ複数行のINSERTにまとめて記録します
+func (r *mysqlRepository) BulkCreate(ctx context.Context, notes []*domain.Note) ([]*domain.Note, error) {
+	if len(notes) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(notes))
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// 1文の複数行INSERTで割り当てられるIDは、先頭のIDからauto_increment_incrementずつ連続します
+		increment, err := autoIncrementIncrement(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		created := make([]*domain.Note, len(notes))
+		for start := 0; start < len(notes); start += maxRowsPerInsert {
+			chunk := notes[start:min(start+maxRowsPerInsert, len(notes))]
+
+			args := make([]interface{}, 0, len(chunk)*4)
+			for _, note := range chunk {
+				// 作成日時が指定されていない（インポートしたノートでない）場合は現在日時になります
+				var createdAt interface{}
+				if !note.CreatedAt.IsZero() {
+					createdAt = note.CreatedAt
+				}
+				args = append(args, note.Title, note.Content, nullableID(note.NotebookID), createdAt)
+			}
+			values := strings.TrimSuffix(strings.Repeat("(?, ?, ?, COALESCE(?, CURRENT_TIMESTAMP)), ", len(chunk)), ", ")
+			result, err := tx.ExecContext(ctx, `INSERT INTO notes (title, content, notebook_id, created_at) VALUES `+values, args...)
+			if err != nil {
+				return fmt.Errorf("failed to insert notes: %w", err)
+			}
+
+			firstID, err := result.LastInsertId()
+			if err != nil {
+				return fmt.Errorf("failed to get last insert id: %w", err)
+			}
+			for i, note := range chunk {
+				id := firstID + int64(i)*increment
+				ids = append(ids, id)
+				// タグとリンクの記録に使うため、IDを設定した複製を作ります
+				c := *note
+				c.ID = id
+				created[start+i] = &c
+			}
+		}
+
+		if err := insertNoteTags(ctx, tx, created); err != nil {
+			return err
+		}
+		if err := insertLinks(ctx, tx, created); err != nil {
+			return err
+		}
+
+		for start := 0; start < len(ids); start += maxRowsPerInsert {
+			chunk := ids[start:min(start+maxRowsPerInsert, len(ids))]
+			query := `INSERT INTO note_revisions (note_id, version, title, content, created_at)
+				SELECT id, version, title, content, updated_at FROM notes WHERE id IN (` + placeholders(len(chunk)) + `)`
+			if _, err := tx.ExecContext(ctx, query, idsArgs(chunk)...); err != nil {
+				return fmt.Errorf("failed to insert note revisions: %w", err)
+			}
+			if err := insertOutboxEvents(ctx, tx, domain.NoteCreated, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+		return nil, err
 	}
 
-	note.ID = id
-	return note, nil
+	// データベースで設定されたタイムスタンプとバージョンを反映するため再取得します
+	byID := make(map[int64]*domain.Note, len(ids))
+	for start := 0; start < len(ids); start += maxRowsPerInsert {
+		chunk := ids[start:min(start+maxRowsPerInsert, len(ids))]
+		query := `SELECT ` + noteColumns + ` FROM notes WHERE id IN (` + placeholders(len(chunk)) + `)`
+		fetched, err := r.queryNotes(ctx, query, idsArgs(chunk)...)
+		if err != nil {
+			return nil, err
+		}
+		for _, note := range fetched {
+			byID[note.ID] = note
+		}
+	}
+
+	result := make([]*domain.Note, len(ids))
+	for i, id := range ids {
+		note, ok := byID[id]
+		if !ok {
+			// 作成直後に別のリクエストで完全に削除された場合です
+			return nil, fmt.Errorf("note with id %d: %w", id, domain.ErrNoteNotFound)
+		}
+		result[i] = note
+	}
+	return result, nil
 }
 
-// GetByID はデータベースからIDでノートを取得します
-func (r *mysqlRepository) GetByID(ctx context.Context, id int64) (*domain.Note, error) {
-	query := `SELECT id, title, content, created_at FROM notes WHERE id = ?`
-	row := r.db.QueryRowContext(ctx, query, id)
+// noteColumns はノートを取得する際のカラム一覧です（scanNoteと順序を合わせます）
+const noteColumns = `id, title, content, created_at, updated_at, version, deleted_at, notebook_id, pinned, archived`
+
+// rowScanner は*sql.Rowと*sql.Rowsに共通するScanメソッドを表します
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanNote はnoteColumnsの順で1行を読み取ります
+func scanNote(row rowScanner) (*domain.Note, error) {
+	return scanNoteWith(row)
+}
 
+// scanNoteWith はnoteColumnsに続けてextraのカラムを読み取ります
+func scanNoteWith(row rowScanner, extra ...interface{}) (*domain.Note, error) {
 	var note domain.Note
-	err := row.Scan(&note.ID, &note.Title, &note.Content, &note.CreatedAt)
+	var deletedAt sql.NullTime
+	var notebookID sql.NullInt64
+	dest := []interface{}{&note.ID, &note.Title, &note.Content, &note.CreatedAt, &note.UpdatedAt, &note.Version, &deletedAt, &notebookID, &note.Pinned, &note.Archived}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if deletedAt.Valid {
+		note.DeletedAt = &deletedAt.Time
+	}
+	note.NotebookID = notebookID.Int64
+	return &note, nil
+}
+
+// nullableID はIDが0の場合にNULLとして保存する値を返します
+func nullableID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// queryNotes はnoteColumnsを選択するクエリを実行して全行を読み取ります
+func (r *mysqlRepository) queryNotes(ctx context.Context, query string, args ...interface{}) ([]*domain.Note, error) {
+	return selectNotes(ctx, r.db, query, args...)
+}
+
+// selectNotes はnoteColumnsを選択するクエリを実行し、タグを読み込んだノートを返します
+func selectNotes(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]*domain.Note, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notes: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []*domain.Note
+	for rows.Next() {
+		note, err := scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notes: %w", err)
+	}
+
+	if err := attachTags(ctx, db, notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// GetByID はデータベースからIDでゴミ箱にないノートを取得します
+func (r *mysqlRepository) GetByID(ctx context.Context, id int64) (*domain.Note, error) {
+	query := `SELECT ` + noteColumns + ` FROM notes WHERE id = ? AND deleted_at IS NULL`
+	return r.getNote(ctx, query, id)
+}
+
+// GetByIDs はデータベースからIDの一覧でゴミ箱にないノートを1回のクエリで取得します
+func (r *mysqlRepository) GetByIDs(ctx context.Context, ids []int64) ([]*domain.Note, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := `SELECT ` + noteColumns + ` FROM notes WHERE id IN (` + placeholders(len(ids)) + `) AND deleted_at IS NULL`
+	return r.queryNotes(ctx, query, idsArgs(ids)...)
+}
+
+// GetIncludingTrashed はゴミ箱にあるノートも含めてIDでノートを取得します
+func (r *mysqlRepository) GetIncludingTrashed(ctx context.Context, id int64) (*domain.Note, error) {
+	query := `SELECT ` + noteColumns + ` FROM notes WHERE id = ?`
+	return r.getNote(ctx, query, id)
+}
+
+// getNote はIDを条件とするクエリで1件のノートを取得します
+func (r *mysqlRepository) getNote(ctx context.Context, query string, id int64) (*domain.Note, error) {
+	note, err := scanNote(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("note with id %d not found", id)
+			return nil, fmt.Errorf("note with id %d: %w", id, domain.ErrNoteNotFound)
 		}
 		return nil, fmt.Errorf("failed to scan note: %w", err)
 	}
 
-	return &note, nil
+	if err := attachTags(ctx, r.db, []*domain.Note{note}); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// Update はバージョンを条件にノートを更新し、新しいリビジョンと更新イベントを記録します
+func (r *mysqlRepository) Update(ctx context.Context, note *domain.Note, expectedVersion int64) (*domain.Note, error) {
+	var conflict bool
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE notes SET title = ?, content = ?, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND version = ? AND deleted_at IS NULL`
+		result, err := tx.ExecContext(ctx, query, note.Title, note.Content, note.ID, expectedVersion)
+		if err != nil {
+			return fmt.Errorf("failed to update note: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			conflict = true
+			return nil
+		}
+
+		if err := replaceTags(ctx, tx, note.ID, note.Tags); err != nil {
+			return err
+		}
+		if err := replaceLinks(ctx, tx, note.ID, note.Links()); err != nil {
+			return err
+		}
+		if err := insertRevision(ctx, tx, note.ID); err != nil {
+			return err
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteUpdated, note.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if conflict {
+		// 存在しないのかバージョンが一致しないのかを区別します
+		if _, err := r.GetByID(ctx, note.ID); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("note with id %d at version %d: %w", note.ID, expectedVersion, domain.ErrVersionConflict)
+	}
+
+	return r.GetByID(ctx, note.ID)
+}
+
+// Move はノートのノートブックを変更し、更新イベントを記録します。バージョンは変更しません
+func (r *mysqlRepository) Move(ctx context.Context, id, notebookID int64) (*domain.Note, error) {
+	var affected int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE notes SET notebook_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL`
+		result, err := tx.ExecContext(ctx, query, nullableID(notebookID), id)
+		if err != nil {
+			return fmt.Errorf("failed to move note: %w", err)
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteUpdated, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("note with id %d: %w", id, domain.ErrNoteNotFound)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// SetState はノートの固定とアーカイブの状態を保存し、更新イベントを記録します。バージョンは変更しません
+func (r *mysqlRepository) SetState(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	var affected int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE notes SET pinned = ?, archived = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL`
+		result, err := tx.ExecContext(ctx, query, note.Pinned, note.Archived, note.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update note state: %w", err)
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteUpdated, note.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("note with id %d: %w", note.ID, domain.ErrNoteNotFound)
+	}
+
+	return r.GetByID(ctx, note.ID)
+}
+
+// insertRevision はノートの現在の内容をリビジョンとして記録します
+func insertRevision(ctx context.Context, tx *sql.Tx, noteID int64) error {
+	query := `INSERT INTO note_revisions (note_id, version, title, content, created_at)
+		SELECT id, version, title, content, updated_at FROM notes WHERE id = ?`
+	if _, err := tx.ExecContext(ctx, query, noteID); err != nil {
+		return fmt.Errorf("failed to insert note revision: %w", err)
+	}
+	return nil
+}
+
+// SoftDelete はノートをゴミ箱に移動し、発リンクを削除して削除イベントを記録します
+func (r *mysqlRepository) SoftDelete(ctx context.Context, id, expectedVersion int64) (*domain.Note, error) {
+	var affected int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE notes SET deleted_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND deleted_at IS NULL AND (? = 0 OR version = ?)`
+		result, err := tx.ExecContext(ctx, query, id, expectedVersion, expectedVersion)
+		if err != nil {
+			return fmt.Errorf("failed to delete note: %w", err)
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		if err := deleteLinks(ctx, tx, id); err != nil {
+			return err
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteDeleted, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("note with id %d at version %d: %w", id, expectedVersion, domain.ErrVersionConflict)
+	}
+
+	return r.GetIncludingTrashed(ctx, id)
+}
+
+// Restore はゴミ箱にあるノートを元に戻し、本文から発リンクを記録し直して復元イベントを記録します
+func (r *mysqlRepository) Restore(ctx context.Context, id int64) (*domain.Note, error) {
+	var affected int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE notes SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+		result, err := tx.ExecContext(ctx, query, id)
+		if err != nil {
+			return fmt.Errorf("failed to restore note: %w", err)
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		note := domain.Note{ID: id}
+		if err := tx.QueryRowContext(ctx, `SELECT content FROM notes WHERE id = ?`, id).Scan(&note.Content); err != nil {
+			return fmt.Errorf("failed to get note content: %w", err)
+		}
+		if err := replaceLinks(ctx, tx, id, note.Links()); err != nil {
+			return err
+		}
+
+		return insertOutboxEvent(ctx, tx, domain.NoteRestored, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, r.notTrashedError(ctx, id)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// ListTrashed はゴミ箱にあるノートをIDの降順で取得します。beforeIDが0より大きい場合はそれより小さいIDのみを返します
+func (r *mysqlRepository) ListTrashed(ctx context.Context, limit int, beforeID int64) ([]*domain.Note, error) {
+	query := `SELECT ` + noteColumns + ` FROM notes
+		WHERE deleted_at IS NOT NULL AND (? = 0 OR id < ?)
+		ORDER BY id DESC LIMIT ?`
+	return r.queryNotes(ctx, query, beforeID, beforeID, limit)
+}
+
+// Purge はゴミ箱にあるノートを完全に削除します（リビジョンは外部キーにより削除されます）
+// 削除と同じトランザクションで完全削除イベントを記録します
+func (r *mysqlRepository) Purge(ctx context.Context, id int64) error {
+	trashed := true
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var lockedID int64
+		err := tx.QueryRowContext(ctx, `SELECT id FROM notes WHERE id = ? AND deleted_at IS NOT NULL FOR UPDATE`, id).Scan(&lockedID)
+		if err == sql.ErrNoRows {
+			trashed = false
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock note: %w", err)
+		}
+
+		// 削除後はノートのバージョンを読めないため、削除前にイベントを記録します
+		if err := insertOutboxEvent(ctx, tx, domain.NotePurged, id); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM notes WHERE id = ?`, id); err != nil {
+			return fmt.Errorf("failed to purge note: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !trashed {
+		return r.notTrashedError(ctx, id)
+	}
+
+	return nil
+}
+
+// PurgeTrashedOlderThan はゴミ箱に移動されてからageを超えたノートを最大limit件完全に削除します
+// 削除と同じトランザクションで削除したノートごとに完全削除イベントを記録します
+func (r *mysqlRepository) PurgeTrashedOlderThan(ctx context.Context, age time.Duration, limit int) (int64, error) {
+	var ids []int64
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `SELECT id FROM notes
+			WHERE deleted_at IS NOT NULL AND deleted_at < CURRENT_TIMESTAMP - INTERVAL ? SECOND
+			ORDER BY deleted_at LIMIT ? FOR UPDATE`
+		rows, err := tx.QueryContext(ctx, query, int64(age/time.Second), limit)
+		if err != nil {
+			return fmt.Errorf("failed to query trashed notes: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				return fmt.Errorf("failed to scan note id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate trashed notes: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := insertOutboxEvents(ctx, tx, domain.NotePurged, ids); err != nil {
+			return err
+		}
+		query = `DELETE FROM notes WHERE id IN (` + placeholders(len(ids)) + `)`
+		if _, err := tx.ExecContext(ctx, query, idsArgs(ids)...); err != nil {
+			return fmt.Errorf("failed to purge trashed notes: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
+}
+
+// Find は条件に一致するゴミ箱にないノートを固定されたノートを先頭にIDの降順で取得します
+func (r *mysqlRepository) Find(ctx context.Context, filter usecase.NoteFilter, limit int, after usecase.NoteCursor) ([]*domain.Note, error) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+
+	if after.ID > 0 {
+		// (pinned, id) の降順でカーソルより後の行に絞り込みます
+		if after.Pinned {
+			conditions = append(conditions, "(pinned = FALSE OR id < ?)")
+		} else {
+			conditions = append(conditions, "(pinned = FALSE AND id < ?)")
+		}
+		args = append(args, after.ID)
+	}
+	if !filter.IncludeArchived {
+		conditions = append(conditions, "archived = FALSE")
+	}
+	if len(filter.Tags) > 0 {
+		// すべてのタグが付いているノートに絞り込みます
+		conditions = append(conditions, `id IN (
+			SELECT nt.note_id FROM note_tags nt JOIN tags t ON t.id = nt.tag_id
+			WHERE t.name IN (`+placeholders(len(filter.Tags))+`)
+			GROUP BY nt.note_id HAVING COUNT(*) = ?)`)
+		for _, tag := range filter.Tags {
+			args = append(args, tag)
+		}
+		args = append(args, len(filter.Tags))
+	}
+	if filter.NotebookID > 0 {
+		if filter.IncludeDescendants {
+			// 指定したノートブックとその子孫のノートブックのノートに絞り込みます
+			conditions = append(conditions, `notebook_id IN (
+				WITH RECURSIVE subtree (id) AS (
+					SELECT ?
+					UNION ALL
+					SELECT nb.id FROM notebooks nb JOIN subtree s ON nb.parent_id = s.id
+				)
+				SELECT id FROM subtree)`)
+		} else {
+			conditions = append(conditions, "notebook_id = ?")
+		}
+		args = append(args, filter.NotebookID)
+	}
+
+	query := `SELECT ` + noteColumns + ` FROM notes
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY pinned DESC, id DESC LIMIT ?`
+	args = append(args, limit)
+
+	return r.queryNotes(ctx, query, args...)
+}
+
+// List はゴミ箱にないノートをIDの昇順で取得します
+func (r *mysqlRepository) List(ctx context.Context, limit int, afterID int64) ([]*domain.Note, error) {
+	query := `SELECT ` + noteColumns + ` FROM notes
+		WHERE deleted_at IS NULL AND id > ?
+		ORDER BY id LIMIT ?`
+	return r.queryNotes(ctx, query, afterID, limit)
+}
+
+// ListForExport はfilterに一致するゴミ箱にないノートをIDの昇順で取得します
+func (r *mysqlRepository) ListForExport(ctx context.Context, filter usecase.ExportFilter, limit int, afterID int64) ([]*domain.Note, error) {
+	conditions := []string{"deleted_at IS NULL", "id > ?"}
+	args := []interface{}{afterID}
+
+	if !filter.UpdatedFrom.IsZero() {
+		conditions = append(conditions, "updated_at >= ?")
+		args = append(args, filter.UpdatedFrom)
+	}
+	if !filter.UpdatedTo.IsZero() {
+		conditions = append(conditions, "updated_at < ?")
+		args = append(args, filter.UpdatedTo)
+	}
+	if len(filter.Tags) > 0 {
+		// すべてのタグが付いているノートに絞り込みます
+		conditions = append(conditions, `id IN (
+			SELECT nt.note_id FROM note_tags nt JOIN tags t ON t.id = nt.tag_id
+			WHERE t.name IN (`+placeholders(len(filter.Tags))+`)
+			GROUP BY nt.note_id HAVING COUNT(*) = ?)`)
+		for _, tag := range filter.Tags {
+			args = append(args, tag)
+		}
+		args = append(args, len(filter.Tags))
+	}
+
+	query := `SELECT ` + noteColumns + ` FROM notes
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY id LIMIT ?`
+	args = append(args, limit)
+
+	return r.queryNotes(ctx, query, args...)
+}
+
+// FindIDsByContentHash はcontent_hashが一致するゴミ箱にないノートのIDをハッシュごとに取得します
+// 同じハッシュのノートが複数ある場合は最も古いノートのIDを返します
+func (r *mysqlRepository) FindIDsByContentHash(ctx context.Context, hashes []string) (map[string]int64, error) {
+	ids := make(map[string]int64, len(hashes))
+	for start := 0; start < len(hashes); start += maxRowsPerInsert {
+		chunk := hashes[start:min(start+maxRowsPerInsert, len(hashes))]
+		args := make([]interface{}, len(chunk))
+		for i, hash := range chunk {
+			args[i] = hash
+		}
+
+		query := `SELECT content_hash, MIN(id) FROM notes
+			WHERE deleted_at IS NULL AND content_hash IN (` + placeholders(len(chunk)) + `)
+			GROUP BY content_hash`
+		rows, err := r.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find notes by content hash: %w", err)
+		}
+		for rows.Next() {
+			var hash string
+			var id int64
+			if err := rows.Scan(&hash, &id); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan note id: %w", err)
+			}
+			ids[hash] = id
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate notes: %w", err)
+		}
+	}
+	return ids, nil
+}
+
+// Search はFULLTEXTインデックス（ngramパーサー）でタイトルと本文を検索します
+func (r *mysqlRepository) Search(ctx context.Context, query string, limit, offset int) ([]*domain.SearchHit, error) {
+	q := `SELECT ` + noteColumns + `, MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+		FROM notes
+		WHERE deleted_at IS NULL AND MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE)
+		ORDER BY score DESC, id DESC LIMIT ? OFFSET ?`
+	rows, err := r.db.QueryContext(ctx, q, query, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notes: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*domain.SearchHit
+	for rows.Next() {
+		var score float64
+		note, err := scanNoteWith(rows, &score)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		hits = append(hits, &domain.SearchHit{Note: note, Score: score})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate search results: %w", err)
+	}
+
+	notes := make([]*domain.Note, 0, len(hits))
+	for _, hit := range hits {
+		notes = append(notes, hit.Note)
+	}
+	if err := attachTags(ctx, r.db, notes); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// notTrashedError はゴミ箱への操作が対象なしだった理由を判定します
+func (r *mysqlRepository) notTrashedError(ctx context.Context, id int64) error {
+	if _, err := r.GetIncludingTrashed(ctx, id); err != nil {
+		return err
+	}
+	return fmt.Errorf("note with id %d: %w", id, domain.ErrNoteNotTrashed)
 }
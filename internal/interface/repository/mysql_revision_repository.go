@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// mysqlRevisionRepository はNoteRevisionRepositoryインターフェースを実装します
+type mysqlRevisionRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLRevisionRepository は新しいMySQLリビジョンリポジトリを作成します
+func NewMySQLRevisionRepository(db *sql.DB) usecase.NoteRevisionRepository {
+	return &mysqlRevisionRepository{db: db}
+}
+
+// List はノートのリビジョンをバージョンの降順で取得します
+func (r *mysqlRevisionRepository) List(ctx context.Context, noteID int64, limit int, beforeVersion int64) ([]*domain.NoteRevision, error) {
+	query := `SELECT note_id, version, title, content, created_at FROM note_revisions
+		WHERE note_id = ? AND (? = 0 OR version < ?)
+		ORDER BY version DESC LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, noteID, beforeVersion, beforeVersion, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query note revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.NoteRevision
+	for rows.Next() {
+		var revision domain.NoteRevision
+		if err := rows.Scan(&revision.NoteID, &revision.Version, &revision.Title, &revision.Content, &revision.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan note revision: %w", err)
+		}
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate note revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// Get はノートIDとバージョンでリビジョンを取得します
+func (r *mysqlRevisionRepository) Get(ctx context.Context, noteID, version int64) (*domain.NoteRevision, error) {
+	query := `SELECT note_id, version, title, content, created_at FROM note_revisions WHERE note_id = ? AND version = ?`
+	row := r.db.QueryRowContext(ctx, query, noteID, version)
+
+	var revision domain.NoteRevision
+	err := row.Scan(&revision.NoteID, &revision.Version, &revision.Title, &revision.Content, &revision.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("revision %d of note %d: %w", version, noteID, domain.ErrRevisionNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan note revision: %w", err)
+	}
+
+	return &revision, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// mysqlTagRepository はTagRepositoryインターフェースを実装します
+type mysqlTagRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLTagRepository は新しいMySQLタグリポジトリを作成します
+func NewMySQLTagRepository(db *sql.DB) usecase.TagRepository {
+	return &mysqlTagRepository{db: db}
+}
+
+// ListWithCounts はゴミ箱にないノートに付いているタグとノート数を取得します
+func (r *mysqlTagRepository) ListWithCounts(ctx context.Context) ([]*domain.TagCount, error) {
+	query := `SELECT t.name, COUNT(*) AS note_count
+		FROM tags t
+		JOIN note_tags nt ON nt.tag_id = t.id
+		JOIN notes n ON n.id = nt.note_id AND n.deleted_at IS NULL
+		GROUP BY t.id, t.name
+		ORDER BY note_count DESC, t.name`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*domain.TagCount
+	for rows.Next() {
+		var tag domain.TagCount
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, &tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tags: %w", err)
+	}
+
+	return tags, nil
+}
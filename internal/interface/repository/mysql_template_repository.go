@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// templateColumns はテンプレートを取得する際のカラム一覧です（scanTemplateと順序を合わせます）
+const templateColumns = `id, name, title_template, content_template, created_at, updated_at`
+
+// mysqlTemplateRepository はNoteTemplateRepositoryインターフェースを実装します
+type mysqlTemplateRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLTemplateRepository は新しいMySQLテンプレートリポジトリを作成します
+func NewMySQLTemplateRepository(db *sql.DB) usecase.NoteTemplateRepository {
+	return &mysqlTemplateRepository{db: db}
+}
+
+// scanTemplate はtemplateColumnsの順で1行を読み取ります
+func scanTemplate(row rowScanner) (*domain.NoteTemplate, error) {
+	var t domain.NoteTemplate
+	if err := row.Scan(&t.ID, &t.Name, &t.TitleTemplate, &t.ContentTemplate, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Create はデータベースに新しいテンプレートを作成します
+func (r *mysqlTemplateRepository) Create(ctx context.Context, t *domain.NoteTemplate) (*domain.NoteTemplate, error) {
+	query := `INSERT INTO note_templates (name, title_template, content_template) VALUES (?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, t.Name, t.TitleTemplate, t.ContentTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert note template: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID はデータベースからIDでテンプレートを取得します
+func (r *mysqlTemplateRepository) GetByID(ctx context.Context, id int64) (*domain.NoteTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM note_templates WHERE id = ?`
+	t, err := scanTemplate(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("note template with id %d: %w", id, domain.ErrTemplateNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan note template: %w", err)
+	}
+	return t, nil
+}
+
+// Update はテンプレートの名前と内容を更新します
+func (r *mysqlTemplateRepository) Update(ctx context.Context, t *domain.NoteTemplate) (*domain.NoteTemplate, error) {
+	query := `UPDATE note_templates SET name = ?, title_template = ?, content_template = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, t.Name, t.TitleTemplate, t.ContentTemplate, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update note template: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("note template with id %d: %w", t.ID, domain.ErrTemplateNotFound)
+	}
+
+	return r.GetByID(ctx, t.ID)
+}
+
+// Delete はテンプレートを削除します
+func (r *mysqlTemplateRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM note_templates WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete note template: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("note template with id %d: %w", id, domain.ErrTemplateNotFound)
+	}
+
+	return nil
+}
+
+// List はテンプレートをIDの昇順で取得します。afterIDより大きいIDのみを返します
+func (r *mysqlTemplateRepository) List(ctx context.Context, limit int, afterID int64) ([]*domain.NoteTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM note_templates WHERE id > ? ORDER BY id LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query note templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*domain.NoteTemplate
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note template: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate note templates: %w", err)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+	"strings"
+	"time"
+)
+
+// webhookDeliveryColumns は送信記録を取得する際のカラム一覧です（scanWebhookDeliveryと順序を合わせます）
+const webhookDeliveryColumns = `id, subscription_id, event_id, event_type, note_id, version, occurred_at, status, attempts,
+	last_status_code, COALESCE(last_error, ''), next_attempt_at, last_attempt_at, delivered_at, created_at`
+
+// mysqlWebhookDeliveryRepository はWebhookDeliveryRepositoryインターフェースを実装します
+type mysqlWebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLWebhookDeliveryRepository は新しいMySQL Webhook送信記録リポジトリを作成します
+func NewMySQLWebhookDeliveryRepository(db *sql.DB) usecase.WebhookDeliveryRepository {
+	return &mysqlWebhookDeliveryRepository{db: db}
+}
+
+// scanWebhookDelivery はwebhookDeliveryColumnsの順で1行を読み取ります
+func scanWebhookDelivery(row rowScanner) (*domain.WebhookDelivery, error) {
+	var d domain.WebhookDelivery
+	var eventType, status string
+	var lastAttemptAt, deliveredAt sql.NullTime
+	err := row.Scan(&d.ID, &d.WebhookID, &d.Event.ID, &eventType, &d.Event.NoteID, &d.Event.Version, &d.Event.OccurredAt,
+		&status, &d.Attempts, &d.LastStatusCode, &d.LastError, &d.NextAttemptAt, &lastAttemptAt, &deliveredAt, &d.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	d.Event.Type = domain.NoteEventType(eventType)
+	d.Status = domain.WebhookDeliveryStatus(status)
+	if lastAttemptAt.Valid {
+		d.LastAttemptAt = &lastAttemptAt.Time
+	}
+	if deliveredAt.Valid {
+		d.DeliveredAt = &deliveredAt.Time
+	}
+	return &d, nil
+}
+
+// Enqueue はイベントをWebhookごとの送信待ちとして1つのINSERTで記録します
+// (subscription_id, event_id)の一意制約により、同じイベントを再び受け取った場合は記録しません
+func (r *mysqlWebhookDeliveryRepository) Enqueue(ctx context.Context, webhookIDs []int64, event *domain.NoteEvent) (int, error) {
+	values := make([]string, len(webhookIDs))
+	args := make([]interface{}, 0, len(webhookIDs)*6)
+	for i, id := range webhookIDs {
+		values[i] = "(?, ?, ?, ?, ?, ?)"
+		args = append(args, id, event.ID, string(event.Type), event.NoteID, event.Version, event.OccurredAt)
+	}
+
+	query := `INSERT IGNORE INTO webhook_deliveries (subscription_id, event_id, event_type, note_id, version, occurred_at)
+		VALUES ` + strings.Join(values, ", ")
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert webhook deliveries: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(affected), nil
+}
+
+// ClaimDue は送信予定時刻を過ぎた送信待ちをロックして取得し、送信予定時刻をlease後に延ばします
+// SKIP LOCKEDにより、複数のレプリカのワーカーが同じ送信待ちを同時に取得しないようにします
+func (r *mysqlWebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= CURRENT_TIMESTAMP(6)
+			ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED`
+		rows, err := tx.QueryContext(ctx, query, string(domain.WebhookDeliveryPending), limit)
+		if err != nil {
+			return fmt.Errorf("failed to query webhook deliveries: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d, err := scanWebhookDelivery(rows)
+			if err != nil {
+				return fmt.Errorf("failed to scan webhook delivery: %w", err)
+			}
+			deliveries = append(deliveries, d)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate webhook deliveries: %w", err)
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		args := make([]interface{}, 0, len(deliveries)+1)
+		args = append(args, lease.Microseconds())
+		for _, d := range deliveries {
+			args = append(args, d.ID)
+		}
+		update := `UPDATE webhook_deliveries SET next_attempt_at = CURRENT_TIMESTAMP(6) + INTERVAL ? MICROSECOND
+			WHERE id IN (` + placeholders(len(deliveries)) + `)`
+		if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+			return fmt.Errorf("failed to lease webhook deliveries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// MarkSucceeded は送信に成功したことを記録します
+func (r *mysqlWebhookDeliveryRepository) MarkSucceeded(ctx context.Context, id int64, statusCode int) error {
+	query := `UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1, last_status_code = ?, last_error = NULL,
+		last_attempt_at = CURRENT_TIMESTAMP(6), delivered_at = CURRENT_TIMESTAMP(6) WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, string(domain.WebhookDeliverySucceeded), statusCode, id); err != nil {
+		return fmt.Errorf("failed to record webhook delivery success: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed は失敗の原因を記録し、送信予定時刻をretryAfter後にします
+func (r *mysqlWebhookDeliveryRepository) MarkFailed(ctx context.Context, id int64, statusCode int, cause string, retryAfter time.Duration) error {
+	query := `UPDATE webhook_deliveries SET attempts = attempts + 1, last_status_code = ?, last_error = ?,
+		last_attempt_at = CURRENT_TIMESTAMP(6), next_attempt_at = CURRENT_TIMESTAMP(6) + INTERVAL ? MICROSECOND WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, statusCode, truncateError(cause), retryAfter.Microseconds(), id); err != nil {
+		return fmt.Errorf("failed to record webhook delivery failure: %w", err)
+	}
+	return nil
+}
+
+// MarkAbandoned は失敗の原因を記録し、以降は取得されないようにします
+func (r *mysqlWebhookDeliveryRepository) MarkAbandoned(ctx context.Context, id int64, statusCode int, cause string) error {
+	query := `UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1, last_status_code = ?, last_error = ?,
+		last_attempt_at = CURRENT_TIMESTAMP(6) WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, string(domain.WebhookDeliveryFailed), statusCode, truncateError(cause), id); err != nil {
+		return fmt.Errorf("failed to record abandoned webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// List はWebhookの送信記録をIDの降順で取得します。beforeIDが0より大きい場合はそれより小さいIDのみを返します
+func (r *mysqlWebhookDeliveryRepository) List(ctx context.Context, webhookID int64, limit int, beforeID int64) ([]*domain.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries
+		WHERE subscription_id = ? AND (? = 0 OR id < ?) ORDER BY id DESC LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, webhookID, beforeID, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
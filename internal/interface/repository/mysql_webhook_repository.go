@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+	"strings"
+)
+
+// webhookColumns はWebhookを取得する際のカラム一覧です（scanWebhookと順序を合わせます）
+const webhookColumns = `id, url, event_types, secret, active, created_at, updated_at`
+
+// mysqlWebhookRepository はWebhookRepositoryインターフェースを実装します
+type mysqlWebhookRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLWebhookRepository は新しいMySQL Webhookリポジトリを作成します
+func NewMySQLWebhookRepository(db *sql.DB) usecase.WebhookRepository {
+	return &mysqlWebhookRepository{db: db}
+}
+
+// scanWebhook はwebhookColumnsの順で1行を読み取ります
+func scanWebhook(row rowScanner) (*domain.Webhook, error) {
+	var w domain.Webhook
+	var eventTypes string
+	if err := row.Scan(&w.ID, &w.URL, &eventTypes, &w.Secret, &w.Active, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return nil, err
+	}
+	w.EventTypes = parseEventTypes(eventTypes)
+	return &w, nil
+}
+
+// formatEventTypes はイベントの種類をカンマ区切りの文字列にします。空の場合は空文字列になります
+func formatEventTypes(eventTypes []domain.NoteEventType) string {
+	parts := make([]string, len(eventTypes))
+	for i, t := range eventTypes {
+		parts[i] = string(t)
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseEventTypes はformatEventTypesの結果をイベントの種類に戻します
+func parseEventTypes(s string) []domain.NoteEventType {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	eventTypes := make([]domain.NoteEventType, len(parts))
+	for i, p := range parts {
+		eventTypes[i] = domain.NoteEventType(p)
+	}
+	return eventTypes
+}
+
+// Create はデータベースに新しいWebhookを作成します
+func (r *mysqlWebhookRepository) Create(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	query := `INSERT INTO webhook_subscriptions (url, event_types, secret, active) VALUES (?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, w.URL, formatEventTypes(w.EventTypes), w.Secret, w.Active)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert webhook: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID はデータベースからIDでWebhookを取得します
+func (r *mysqlWebhookRepository) GetByID(ctx context.Context, id int64) (*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id = ?`
+	w, err := scanWebhook(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("webhook with id %d: %w", id, domain.ErrWebhookNotFound)
+		}
+		return nil, fmt.Errorf("failed to scan webhook: %w", err)
+	}
+	return w, nil
+}
+
+// Update はWebhookの送信先・イベントの種類・シークレット・有効状態を更新します
+func (r *mysqlWebhookRepository) Update(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	query := `UPDATE webhook_subscriptions SET url = ?, event_types = ?, secret = ?, active = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, w.URL, formatEventTypes(w.EventTypes), w.Secret, w.Active, w.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("webhook with id %d: %w", w.ID, domain.ErrWebhookNotFound)
+	}
+
+	return r.GetByID(ctx, w.ID)
+}
+
+// Delete はWebhookを削除します。送信記録は外部キーにより削除されます
+func (r *mysqlWebhookRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("webhook with id %d: %w", id, domain.ErrWebhookNotFound)
+	}
+
+	return nil
+}
+
+// List はWebhookをIDの昇順で取得します。afterIDより大きいIDのみを返します
+func (r *mysqlWebhookRepository) List(ctx context.Context, limit int, afterID int64) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id > ? ORDER BY id LIMIT ?`
+	return r.query(ctx, query, afterID, limit)
+}
+
+// ListActive は有効なWebhookをIDの昇順ですべて取得します
+func (r *mysqlWebhookRepository) ListActive(ctx context.Context) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE active = TRUE ORDER BY id`
+	return r.query(ctx, query)
+}
+
+// query はWebhookを取得するクエリを実行します
+func (r *mysqlWebhookRepository) query(ctx context.Context, query string, args ...interface{}) ([]*domain.Webhook, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*domain.Webhook
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
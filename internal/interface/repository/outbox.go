@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// insertOutboxEvent はノートの現在のバージョンで変更イベントを送信待ちとして記録します
+// ノートの変更と同じトランザクションで呼び出すことで、変更とイベントの記録を不可分にします
+func insertOutboxEvent(ctx context.Context, tx *sql.Tx, eventType domain.NoteEventType, noteID int64) error {
+	query := `INSERT INTO note_outbox (event_type, note_id, version) SELECT ?, id, version FROM notes WHERE id = ?`
+	if _, err := tx.ExecContext(ctx, query, string(eventType), noteID); err != nil {
+		return fmt.Errorf("failed to insert note outbox event: %w", err)
+	}
+	return nil
+}
+
+// insertOutboxEvents は複数のノートの現在のバージョンで同じ種類の変更イベントを送信待ちとして記録します
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, eventType domain.NoteEventType, noteIDs []int64) error {
+	query := `INSERT INTO note_outbox (event_type, note_id, version)
+		SELECT ?, id, version FROM notes WHERE id IN (` + placeholders(len(noteIDs)) + `) ORDER BY id`
+	if _, err := tx.ExecContext(ctx, query, append([]interface{}{string(eventType)}, idsArgs(noteIDs)...)...); err != nil {
+		return fmt.Errorf("failed to insert note outbox events: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go_test/internal/domain"
+	"strings"
+)
+
+// queryer は*sql.DBと*sql.Txに共通するクエリメソッドを表します
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// placeholders はn個のプレースホルダーをカンマ区切りで返します
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// replaceTags はノートのタグをtagsで置き換えます
+func replaceTags(ctx context.Context, tx *sql.Tx, noteID int64, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM note_tags WHERE note_id = ?`, noteID); err != nil {
+		return fmt.Errorf("failed to delete note tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(tags)+1)
+	for _, tag := range tags {
+		args = append(args, tag)
+	}
+
+	values := strings.TrimSuffix(strings.Repeat("(?), ", len(tags)), ", ")
+	if _, err := tx.ExecContext(ctx, `INSERT IGNORE INTO tags (name) VALUES `+values, args...); err != nil {
+		return fmt.Errorf("failed to insert tags: %w", err)
+	}
+
+	query := `INSERT INTO note_tags (note_id, tag_id) SELECT ?, id FROM tags WHERE name IN (` + placeholders(len(tags)) + `)`
+	if _, err := tx.ExecContext(ctx, query, append([]interface{}{noteID}, args...)...); err != nil {
+		return fmt.Errorf("failed to insert note tags: %w", err)
+	}
+
+	return nil
+}
+
+// insertNoteTags は作成したばかりのノートの一覧のタグを記録します（ノートごとにタグを削除しないためreplaceTagsより少ない文で済みます）
+func insertNoteTags(ctx context.Context, tx *sql.Tx, notes []*domain.Note) error {
+	var names []interface{}
+	seen := make(map[string]bool)
+	for _, note := range notes {
+		for _, tag := range note.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				names = append(names, tag)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	nameRows := make([][]interface{}, len(names))
+	for i, name := range names {
+		nameRows[i] = []interface{}{name}
+	}
+	if err := insertRows(ctx, tx, `INSERT IGNORE INTO tags (name) VALUES`, nameRows); err != nil {
+		return fmt.Errorf("failed to insert tags: %w", err)
+	}
+
+	// タグ名はutf8mb4_binで比較されるため、名前でIDを引き当てられます
+	tagIDs := make(map[string]int64, len(names))
+	rows, err := tx.QueryContext(ctx, `SELECT id, name FROM tags WHERE name IN (`+placeholders(len(names))+`)`, names...)
+	if err != nil {
+		return fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tagIDs[name] = id
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate tags: %w", err)
+	}
+
+	var noteTagRows [][]interface{}
+	for _, note := range notes {
+		for _, tag := range note.Tags {
+			noteTagRows = append(noteTagRows, []interface{}{note.ID, tagIDs[tag]})
+		}
+	}
+	if err := insertRows(ctx, tx, `INSERT INTO note_tags (note_id, tag_id) VALUES`, noteTagRows); err != nil {
+		return fmt.Errorf("failed to insert note tags: %w", err)
+	}
+	return nil
+}
+
+// attachTags はノートの一覧にタグを読み込みます
+func attachTags(ctx context.Context, q queryer, notes []*domain.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*domain.Note, len(notes))
+	args := make([]interface{}, 0, len(notes))
+	for _, note := range notes {
+		note.Tags = []string{}
+		byID[note.ID] = note
+		args = append(args, note.ID)
+	}
+
+	query := `SELECT nt.note_id, t.name FROM note_tags nt JOIN tags t ON t.id = nt.tag_id
+		WHERE nt.note_id IN (` + placeholders(len(args)) + `) ORDER BY t.name`
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query note tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var noteID int64
+		var name string
+		if err := rows.Scan(&noteID, &name); err != nil {
+			return fmt.Errorf("failed to scan note tag: %w", err)
+		}
+		if note, ok := byID[noteID]; ok {
+			note.Tags = append(note.Tags, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate note tags: %w", err)
+	}
+
+	return nil
+}
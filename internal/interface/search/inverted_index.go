@@ -0,0 +1,375 @@
+package search
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// BM25のパラメータです
+	bm25K1 = 1.2
+	bm25B  = 0.75
+	// titleBoost はタイトル中の語の出現回数に掛ける重みです
+	titleBoost = 2
+)
+
+// document はインデックスに登録されたノートとその語の出現回数を保持します
+type document struct {
+	note   *domain.Note
+	terms  map[string]int
+	length int
+}
+
+// InvertedIndex はSearchIndexインターフェースを実装するメモリ上の転置インデックスです
+// pathが指定された場合はスナップショットをファイルに保存し、起動時に読み込みます
+// インデックスは同じプロセスでの書き込みでのみ更新されるため、サーバーを1レプリカで動かす場合にのみ使用できます
+type InvertedIndex struct {
+	mu       sync.RWMutex
+	docs     map[int64]*document
+	postings map[string]map[int64]int
+	totalLen int
+	path     string
+	dirty    bool
+}
+
+var _ usecase.SearchIndex = (*InvertedIndex)(nil)
+
+// NewInvertedIndex は新しい転置インデックスを作成します
+// pathにスナップショットが存在する場合は読み込みます。pathが空の場合は永続化しません
+func NewInvertedIndex(path string) (*InvertedIndex, error) {
+	idx := &InvertedIndex{
+		docs:     make(map[int64]*document),
+		postings: make(map[string]map[int64]int),
+		path:     path,
+	}
+	if path == "" {
+		return idx, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return idx, nil
+		}
+		return nil, fmt.Errorf("failed to open search index snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var notes []*domain.Note
+	if err := gob.NewDecoder(f).Decode(&notes); err != nil {
+		return nil, fmt.Errorf("failed to decode search index snapshot: %w", err)
+	}
+	for _, note := range notes {
+		idx.add(note)
+	}
+
+	return idx, nil
+}
+
+// Len はインデックスに登録されたノート数を返します
+func (idx *InvertedIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Index はノートを追加または置き換えます
+func (idx *InvertedIndex) Index(ctx context.Context, note *domain.Note) error {
+	stored := *note
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(note.ID)
+	idx.add(&stored)
+	idx.dirty = true
+	return nil
+}
+
+// Remove はノートをインデックスから削除します
+func (idx *InvertedIndex) Remove(ctx context.Context, id int64) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.remove(id) {
+		idx.dirty = true
+	}
+	return nil
+}
+
+// Reset はインデックスを空にします
+func (idx *InvertedIndex) Reset(ctx context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.docs = make(map[int64]*document)
+	idx.postings = make(map[string]map[int64]int)
+	idx.totalLen = 0
+	idx.dirty = true
+	return nil
+}
+
+// Search はクエリのすべての語を含むノートをBM25のスコア順に返します
+func (idx *InvertedIndex) Search(ctx context.Context, query string, limit, offset int) ([]*domain.SearchHit, error) {
+	terms := uniqueTerms(tokenizeQuery(query))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	n := float64(len(idx.docs))
+	avgLen := 1.0
+	if len(idx.docs) > 0 && idx.totalLen > 0 {
+		avgLen = float64(idx.totalLen) / n
+	}
+
+	// 出現文書数の少ない語から候補を絞り込みます
+	sort.Slice(terms, func(i, j int) bool {
+		return len(idx.postings[terms[i]]) < len(idx.postings[terms[j]])
+	})
+
+	var hits []*domain.SearchHit
+	for id := range idx.postings[terms[0]] {
+		doc := idx.docs[id]
+		score := 0.0
+		for _, term := range terms {
+			tf, ok := idx.postings[term][id]
+			if !ok {
+				score = -1
+				break
+			}
+			df := float64(len(idx.postings[term]))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(doc.length)/avgLen)
+			score += idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + norm)
+		}
+		if score < 0 {
+			continue
+		}
+		note := *doc.note
+		hits = append(hits, &domain.SearchHit{Note: &note, Score: score})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Note.ID > hits[j].Note.ID
+	})
+
+	if offset >= len(hits) {
+		return nil, nil
+	}
+	hits = hits[offset:]
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// Save は変更がある場合にスナップショットをファイルに書き込みます
+func (idx *InvertedIndex) Save() error {
+	if idx.path == "" {
+		return nil
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if !idx.dirty {
+		return nil
+	}
+
+	if err := idx.writeSnapshot(idx.path); err != nil {
+		return err
+	}
+	idx.dirty = false
+	return nil
+}
+
+// SaveTo は変更の有無にかかわらずスナップショットをpathに書き込みます
+func (idx *InvertedIndex) SaveTo(path string) error {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return idx.writeSnapshot(path)
+}
+
+// writeSnapshot は登録されたノートをpathに書き込みます。呼び出し側でロックを取得している必要があります
+func (idx *InvertedIndex) writeSnapshot(path string) error {
+	notes := make([]*domain.Note, 0, len(idx.docs))
+	for _, doc := range idx.docs {
+		notes = append(notes, doc.note)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create search index directory: %w", err)
+	}
+
+	// 書き込み途中のファイルを読み込まないよう、一時ファイルに書いてから置き換えます
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create search index snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(notes); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode search index snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write search index snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace search index snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// RunFlusher はctxがキャンセルされるまでinterval間隔でスナップショットを保存します
+func (idx *InvertedIndex) RunFlusher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := idx.Save(); err != nil {
+				log.Printf("Warning: failed to save search index: %v", err)
+			}
+		}
+	}
+}
+
+// add はノートを登録します。呼び出し側でロックを取得している必要があります
+func (idx *InvertedIndex) add(note *domain.Note) {
+	doc := &document{note: note, terms: make(map[string]int)}
+	for _, term := range tokenize(note.Title) {
+		doc.terms[term] += titleBoost
+		doc.length += titleBoost
+	}
+	for _, term := range tokenize(note.Content) {
+		doc.terms[term]++
+		doc.length++
+	}
+
+	for term, tf := range doc.terms {
+		postings, ok := idx.postings[term]
+		if !ok {
+			postings = make(map[int64]int)
+			idx.postings[term] = postings
+		}
+		postings[note.ID] = tf
+	}
+	idx.docs[note.ID] = doc
+	idx.totalLen += doc.length
+}
+
+// remove はノートを削除し、削除した場合にtrueを返します。呼び出し側でロックを取得している必要があります
+func (idx *InvertedIndex) remove(id int64) bool {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return false
+	}
+
+	for term := range doc.terms {
+		postings := idx.postings[term]
+		delete(postings, id)
+		if len(postings) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, id)
+	idx.totalLen -= doc.length
+	return true
+}
+
+// tokenize はテキストをインデックスに登録する語に分割します
+// 漢字・ひらがな・カタカナは1文字の検索にも一致するよう、2文字ずつのN-gramに加えて1文字ずつの語も登録します
+func tokenize(text string) []string {
+	return splitTerms(text, true)
+}
+
+// tokenizeQuery は検索クエリを語に分割します
+// 2文字以上続く漢字・ひらがな・カタカナは2文字ずつのN-gramのみで照合し、1文字の場合はその文字で照合します
+func tokenizeQuery(query string) []string {
+	return splitTerms(query, false)
+}
+
+// splitTerms はテキストを語に分割します
+// タグと同じくNFKCで正規化（全角英数字は半角、半角カナは全角）してから、英数字は小文字化した単語単位、
+// 漢字・ひらがな・カタカナは2文字ずつのN-gramに分割します。unigramsがtrueの場合は1文字ずつの語も含めます
+func splitTerms(text string, unigrams bool) []string {
+	var tokens []string
+	var word []rune
+	var cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 || unigrams {
+			for _, r := range cjk {
+				tokens = append(tokens, string(r))
+			}
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range norm.NFKC.String(text) {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return tokens
+}
+
+// uniqueTerms は重複を除いた語の一覧を返します
+func uniqueTerms(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	unique := terms[:0]
+	for _, term := range terms {
+		if !seen[term] {
+			seen[term] = true
+			unique = append(unique, term)
+		}
+	}
+	return unique
+}
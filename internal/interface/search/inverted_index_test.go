@@ -0,0 +1,105 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go_test/internal/domain"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		index []string
+		query []string
+	}{
+		{
+			name:  "words are lower-cased",
+			text:  "Hello, World",
+			index: []string{"hello", "world"},
+			query: []string{"hello", "world"},
+		},
+		{
+			name:  "full-width letters are normalized",
+			text:  "ＧｏＬａｎｇ１２",
+			index: []string{"golang12"},
+			query: []string{"golang12"},
+		},
+		{
+			name:  "half-width katakana are normalized",
+			text:  "ｶﾀｶﾅ",
+			index: []string{"カ", "タ", "カ", "ナ", "カタ", "タカ", "カナ"},
+			query: []string{"カタ", "タカ", "カナ"},
+		},
+		{
+			name:  "single CJK character",
+			text:  "猫",
+			index: []string{"猫"},
+			query: []string{"猫"},
+		},
+		{
+			name:  "mixed scripts",
+			text:  "Go言語",
+			index: []string{"go", "言", "語", "言語"},
+			query: []string{"go", "言語"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenize(tt.text); !reflect.DeepEqual(got, tt.index) {
+				t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.index)
+			}
+			if got := tokenizeQuery(tt.text); !reflect.DeepEqual(got, tt.query) {
+				t.Errorf("tokenizeQuery(%q) = %q, want %q", tt.text, got, tt.query)
+			}
+		})
+	}
+}
+
+func TestInvertedIndexSearch(t *testing.T) {
+	ctx := context.Background()
+	idx, err := NewInvertedIndex("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	notes := []*domain.Note{
+		{ID: 1, Title: "猫の写真", Content: "かわいい猫"},
+		{ID: 2, Title: "犬の散歩", Content: "ＧＯで書いたツール"},
+		{ID: 3, Title: "東京", Content: "京都ではない"},
+	}
+	for _, note := range notes {
+		if err := idx.Index(ctx, note); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  []int64
+	}{
+		{query: "猫", want: []int64{1}},
+		{query: "京", want: []int64{3}},
+		{query: "東京", want: []int64{3}},
+		{query: "go", want: []int64{2}},
+		{query: "ﾂｰﾙ", want: []int64{2}},
+		{query: "写真 かわいい", want: []int64{1}},
+		{query: "鳥", want: nil},
+	}
+
+	for _, tt := range tests {
+		hits, err := idx.Search(ctx, tt.query, 10, 0)
+		if err != nil {
+			t.Fatalf("Search(%q) error = %v", tt.query, err)
+		}
+		var got []int64
+		for _, hit := range hits {
+			got = append(got, hit.Note.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
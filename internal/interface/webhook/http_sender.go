@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"go_test/internal/usecase"
+)
+
+// maxResponseBodySize は接続を再利用するために読み捨てる応答の本文の最大バイト数です
+const maxResponseBodySize = 64 << 10
+
+// errForbiddenAddress は送信先が内部ネットワークのアドレスに解決された場合に返されます
+var errForbiddenAddress = errors.New("webhook destination resolves to a non-public address")
+
+// sharedAddressSpace はキャリアグレードNATのアドレス範囲（RFC 6598）です
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// httpSender はnet/httpを使ってWebhookSenderインターフェースを実装します
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender は新しいHTTP送信者を作成します
+// timeoutは1回の送信（接続から応答の受信まで）にかける最大時間です
+// allowPrivateNetworksがfalseの場合は、ループバック、プライベート、リンクローカル（クラウドのメタデータを含む）などの
+// 公開されていないアドレスへの接続を拒否します。接続する時点のアドレスで判定するため、DNSの応答を差し替えられても回避できません
+func NewHTTPSender(timeout time.Duration, allowPrivateNetworks bool) usecase.WebhookSender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetworks {
+		dialer.Control = rejectNonPublicAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシを経由するとプロキシのアドレスしか検証できないため、直接接続します
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &httpSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// リダイレクト先には署名を検証しない送信先が含まれうるため、3xxは失敗として扱います
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// rejectNonPublicAddress は接続先が公開されたユニキャストアドレスでない場合に接続を拒否するnet.Dialer.Controlです
+func rejectNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, address)
+	}
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr) {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, addr)
+	}
+	return nil
+}
+
+// Send はbodyをurlにPOSTし、応答のHTTPステータスコードを返します
+func (s *httpSender) Send(ctx context.Context, url string, header map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if errors.Is(err, errForbiddenAddress) {
+			// 内部ネットワークの調査に使われないよう、接続先の詳細は記録しません
+			return 0, errForbiddenAddress
+		}
+		return 0, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPSenderSend(t *testing.T) {
+	var gotMethod, gotSignature, gotContentType string
+	var gotBody []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotSignature = r.Header.Get("X-Webhook-Signature")
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPSender(5*time.Second, true)
+	status, err := sender.Send(context.Background(), receiver.URL, map[string]string{
+		"Content-Type":        "application/json",
+		"X-Webhook-Signature": "sha256=abc",
+	}, []byte(`{"id":"1-0"}`))
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Errorf("Send() status = %d, want %d", status, http.StatusAccepted)
+	}
+	if gotMethod != http.MethodPost || gotContentType != "application/json" || gotSignature != "sha256=abc" || string(gotBody) != `{"id":"1-0"}` {
+		t.Errorf("receiver got method=%q content-type=%q signature=%q body=%q", gotMethod, gotContentType, gotSignature, gotBody)
+	}
+}
+
+func TestHTTPSenderDoesNotFollowRedirects(t *testing.T) {
+	followed := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	})
+	receiver := httptest.NewServer(mux)
+	defer receiver.Close()
+
+	status, err := NewHTTPSender(5*time.Second, true).Send(context.Background(), receiver.URL+"/hook", nil, nil)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if status != http.StatusFound || followed {
+		t.Errorf("Send() status = %d, followed = %v; want %d without following", status, followed, http.StatusFound)
+	}
+}
+
+func TestHTTPSenderRejectsNonPublicDestinations(t *testing.T) {
+	hit := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer receiver.Close()
+
+	// httptestの受信側はループバックアドレスで待ち受けます
+	_, err := NewHTTPSender(5*time.Second, false).Send(context.Background(), receiver.URL, nil, nil)
+	if !errors.Is(err, errForbiddenAddress) {
+		t.Fatalf("Send() error = %v, want %v", err, errForbiddenAddress)
+	}
+	if hit {
+		t.Error("receiver must not be reached")
+	}
+}
+
+func TestRejectNonPublicAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:4700::1111]:443", true},
+		{"127.0.0.1:6379", false},
+		{"[::1]:80", false},
+		{"10.0.0.1:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.1:80", false},
+		{"169.254.169.254:80", false},
+		{"[fe80::1]:80", false},
+		{"[fd00::1]:80", false},
+		{"100.64.0.1:80", false},
+		{"0.0.0.0:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+		{"224.0.0.1:80", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := rejectNonPublicAddress("tcp", tt.address, nil)
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Errorf("rejectNonPublicAddress(%q) error = %v, want allowed = %v", tt.address, err, tt.allowed)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go_test/internal/usecase"
+)
+
+// AttachmentSweeper はどの添付ファイルからも参照されなくなった内容を定期的に削除します
+type AttachmentSweeper struct {
+	attachmentUsecase usecase.AttachmentUsecase
+	grace             time.Duration
+	interval          time.Duration
+}
+
+// NewAttachmentSweeper は新しい添付ファイルスイーパーを作成します
+// graceより新しい内容はアップロード中の可能性があるため削除しません
+func NewAttachmentSweeper(attachmentUsecase usecase.AttachmentUsecase, grace, interval time.Duration) *AttachmentSweeper {
+	return &AttachmentSweeper{
+		attachmentUsecase: attachmentUsecase,
+		grace:             grace,
+		interval:          interval,
+	}
+}
+
+// Run はctxがキャンセルされるまでinterval間隔で削除を実行します
+func (s *AttachmentSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep は1回分の削除を実行します
+func (s *AttachmentSweeper) sweep(ctx context.Context) {
+	deleted, err := s.attachmentUsecase.SweepBlobs(ctx, s.grace)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Warning: failed to sweep attachment blobs: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d unreferenced attachment blobs", deleted)
+	}
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go_test/internal/usecase"
+)
+
+// OutboxRelay は送信待ちのノートの変更イベントを定期的に発行します
+type OutboxRelay struct {
+	relayUsecase usecase.NoteEventRelayUsecase
+	interval     time.Duration
+}
+
+// NewOutboxRelay は新しいアウトボックスリレーを作成します
+func NewOutboxRelay(relayUsecase usecase.NoteEventRelayUsecase, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		relayUsecase: relayUsecase,
+		interval:     interval,
+	}
+}
+
+// Run はctxがキャンセルされるまでinterval間隔で送信待ちのイベントを発行します
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.relay(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay は1回分の発行を実行します
+func (r *OutboxRelay) relay(ctx context.Context) {
+	_, deadLettered, err := r.relayUsecase.RelayPending(ctx)
+	if deadLettered > 0 {
+		log.Printf("Warning: dead-lettered %d note events after repeated publish failures", deadLettered)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Warning: failed to relay note events: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go_test/internal/usecase"
+)
+
+// TrashPurger は保持期間を過ぎたゴミ箱のノートを定期的に完全削除します
+type TrashPurger struct {
+	trashUsecase usecase.TrashUsecase
+	retention    time.Duration
+	interval     time.Duration
+}
+
+// NewTrashPurger は新しいゴミ箱パージャーを作成します
+func NewTrashPurger(trashUsecase usecase.TrashUsecase, retention, interval time.Duration) *TrashPurger {
+	return &TrashPurger{
+		trashUsecase: trashUsecase,
+		retention:    retention,
+		interval:     interval,
+	}
+}
+
+// Run はctxがキャンセルされるまでinterval間隔でパージを実行します
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge は1回分のパージを実行します
+func (p *TrashPurger) purge(ctx context.Context) {
+	purged, err := p.trashUsecase.PurgeExpired(ctx, p.retention)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Warning: failed to purge trashed notes: %v", err)
+		}
+		return
+	}
+	if purged > 0 {
+		log.Printf("Purged %d notes from the trash", purged)
+	}
+}
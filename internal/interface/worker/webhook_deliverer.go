@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go_test/internal/usecase"
+)
+
+// WebhookDeliverer は送信予定時刻を過ぎたWebhookの送信待ちを定期的に送信します
+type WebhookDeliverer struct {
+	dispatchUsecase usecase.WebhookDispatchUsecase
+	interval        time.Duration
+}
+
+// NewWebhookDeliverer は新しいWebhookデリバラーを作成します
+func NewWebhookDeliverer(dispatchUsecase usecase.WebhookDispatchUsecase, interval time.Duration) *WebhookDeliverer {
+	return &WebhookDeliverer{
+		dispatchUsecase: dispatchUsecase,
+		interval:        interval,
+	}
+}
+
+// Run はctxがキャンセルされるまでinterval間隔で送信待ちを送信します
+func (d *WebhookDeliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.deliver(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver は1回分の送信を実行します
+func (d *WebhookDeliverer) deliver(ctx context.Context) {
+	_, abandoned, err := d.dispatchUsecase.DeliverDue(ctx)
+	if abandoned > 0 {
+		log.Printf("Warning: gave up on %d webhook deliveries after repeated failures", abandoned)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Warning: failed to deliver webhooks: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// webhookConsumerGroup はWebhookの送信待ちを記録するコンシューマーグループの名前です
+const webhookConsumerGroup = "webhooks"
+
+// WebhookDispatcher はノートの変更イベントを消費し、Webhookの送信待ちとして記録します
+// すべてのレプリカが同じコンシューマーグループに参加するため、各イベントはいずれか1つのレプリカで記録されます
+type WebhookDispatcher struct {
+	consumer        usecase.NoteEventConsumer
+	dispatchUsecase usecase.WebhookDispatchUsecase
+	name            string
+}
+
+// NewWebhookDispatcher は新しいWebhookディスパッチャーを作成します
+// nameはコンシューマーグループ内でこのレプリカを識別する名前で、レプリカごとに異なる必要があります
+func NewWebhookDispatcher(consumer usecase.NoteEventConsumer, dispatchUsecase usecase.WebhookDispatchUsecase, name string) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		consumer:        consumer,
+		dispatchUsecase: dispatchUsecase,
+		name:            name,
+	}
+}
+
+// Run はctxがキャンセルされるまでイベントを消費します
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	err := d.consumer.Consume(ctx, webhookConsumerGroup, d.name, func(event *domain.NoteEvent) error {
+		if _, err := d.dispatchUsecase.EnqueueDeliveries(ctx, event); err != nil {
+			return fmt.Errorf("failed to enqueue webhook deliveries for note event %s: %w", event.ID, err)
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Warning: webhook dispatcher stopped: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"go_test/internal/domain"
+	"io"
+	"time"
+)
+
+// sweepBatchSize は参照の有無を一度に確認するダイジェストの件数です
+const sweepBatchSize = 500
+
+// attachmentInteractor はAttachmentUsecaseインターフェースを実装します
+type attachmentInteractor struct {
+	noteRepo       NoteRepository
+	attachmentRepo AttachmentRepository
+	blobStore      BlobStore
+	maxSize        int64
+}
+
+// NewAttachmentInteractor は新しい添付ファイルインタラクターを作成します
+// maxSizeは1ファイルあたりの上限バイト数です
+func NewAttachmentInteractor(noteRepo NoteRepository, attachmentRepo AttachmentRepository, blobStore BlobStore, maxSize int64) AttachmentUsecase {
+	return &attachmentInteractor{
+		noteRepo:       noteRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		maxSize:        maxSize,
+	}
+}
+
+// UploadAttachment はファイルを保存してノートに添付します
+// 内容を保存した後にメタデータの保存に失敗した場合、参照されない内容はSweepBlobsで削除されます
+func (a *attachmentInteractor) UploadAttachment(ctx context.Context, noteID int64, filename string, r io.Reader) (*domain.Attachment, error) {
+	if err := domain.ValidateAttachmentFilename(filename); err != nil {
+		return nil, err
+	}
+	if _, err := a.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	br := bufio.NewReaderSize(r, domain.SniffContentTypeLength)
+	head, err := br.Peek(domain.SniffContentTypeLength)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	contentType := domain.SniffContentType(head)
+
+	digest, size, err := a.blobStore.Put(ctx, br, a.maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	attachment, err := a.attachmentRepo.Create(ctx, &domain.Attachment{
+		NoteID:      noteID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      digest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// DownloadAttachment は添付ファイルの内容を開きます
+func (a *attachmentInteractor) DownloadAttachment(ctx context.Context, noteID, id int64) (*domain.Attachment, io.ReadCloser, error) {
+	if _, err := a.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	attachment, err := a.attachmentRepo.Get(ctx, noteID, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	rc, err := a.blobStore.Open(ctx, attachment.SHA256)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+	return attachment, rc, nil
+}
+
+// ListAttachments はノートの添付ファイルの一覧を取得します
+func (a *attachmentInteractor) ListAttachments(ctx context.Context, noteID int64) ([]*domain.Attachment, error) {
+	if _, err := a.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	attachments, err := a.attachmentRepo.ListByNote(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// DeleteAttachment は添付ファイルを削除します。内容は他から参照されていなければSweepBlobsで削除されます
+func (a *attachmentInteractor) DeleteAttachment(ctx context.Context, noteID, id int64) error {
+	if err := a.attachmentRepo.Delete(ctx, noteID, id); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// SweepBlobs は参照されていない内容を削除します
+// アップロード中の内容はメタデータの保存前に削除されないよう、graceより新しい内容は残します
+// 同じ内容のアップロードは保存日時を更新するため、削除の直前にBlobStoreが確認し直します
+func (a *attachmentInteractor) SweepBlobs(ctx context.Context, grace time.Duration) (int, error) {
+	cutoff := time.Now().Add(-grace)
+	var candidates []string
+	err := a.blobStore.Walk(ctx, func(digest string, storedAt time.Time) error {
+		if storedAt.Before(cutoff) {
+			candidates = append(candidates, digest)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list blobs: %w", err)
+	}
+
+	deleted := 0
+	for start := 0; start < len(candidates); start += sweepBatchSize {
+		end := start + sweepBatchSize
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		batch := candidates[start:end]
+
+		referenced, err := a.attachmentRepo.ReferencedDigests(ctx, batch)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to check blob references: %w", err)
+		}
+		for _, digest := range batch {
+			if referenced[digest] {
+				continue
+			}
+			// 一覧を取得してから同じ内容がアップロードされている場合があるため、削除の直前に保存日時と参照を確認し直します
+			ok, err := a.blobStore.DeleteIfStale(ctx, digest, cutoff, func() (bool, error) {
+				referenced, err := a.attachmentRepo.ReferencedDigests(ctx, []string{digest})
+				if err != nil {
+					return false, fmt.Errorf("failed to check blob references: %w", err)
+				}
+				return !referenced[digest], nil
+			})
+			if err != nil {
+				return deleted, fmt.Errorf("failed to delete blob: %w", err)
+			}
+			if ok {
+				deleted++
+			}
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import "time"
+
+// exponentialBackoff はattempts回目の失敗後に再試行するまでの待機時間を返します
+// 待機時間はinitialから失敗するたびに倍になり、maxを超えません
+func exponentialBackoff(attempts int, initial, max time.Duration) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
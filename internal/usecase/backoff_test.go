@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{9, 2560 * time.Second},
+		{10, time.Hour},
+		{100, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := exponentialBackoff(tt.attempts, 10*time.Second, time.Hour); got != tt.want {
+			t.Errorf("exponentialBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
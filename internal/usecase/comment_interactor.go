@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// commentInteractor はCommentUsecaseインターフェースを実装します
+type commentInteractor struct {
+	noteRepo    NoteRepository
+	commentRepo CommentRepository
+}
+
+// NewCommentInteractor は新しいコメントインタラクターを作成します
+func NewCommentInteractor(noteRepo NoteRepository, commentRepo CommentRepository) CommentUsecase {
+	return &commentInteractor{
+		noteRepo:    noteRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// AddComment は認証された主体を作成者としてコメントを追加します
+// 返信先は同じノートの削除されていないコメントである必要があります
+func (c *commentInteractor) AddComment(ctx context.Context, noteID, parentID int64, body string) (*domain.Comment, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := domain.NewComment(noteID, parentID, principal.ID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	if parentID > 0 {
+		parent, err := c.commentRepo.Get(ctx, noteID, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent comment: %w", err)
+		}
+		if parent.IsDeleted() {
+			return nil, fmt.Errorf("comment with id %d: %w", parentID, domain.ErrCommentNotFound)
+		}
+	}
+
+	created, err := c.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+	return created, nil
+}
+
+// ListComments はコメントを古い順に取得します
+// 返信のあるコメントが削除されてもスレッドを辿れるよう、削除済みのコメントも本文を空にして返します
+func (c *commentInteractor) ListComments(ctx context.Context, noteID, parentID int64, limit int, afterID int64) ([]*domain.Comment, int64, error) {
+	if _, err := c.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get note: %w", err)
+	}
+	if parentID > 0 {
+		if _, err := c.commentRepo.Get(ctx, noteID, parentID); err != nil {
+			return nil, 0, fmt.Errorf("failed to get parent comment: %w", err)
+		}
+	}
+
+	limit = normalizePageSize(limit)
+
+	comments, err := c.commentRepo.List(ctx, noteID, parentID, limit+1, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	var next int64
+	if len(comments) > limit {
+		comments = comments[:limit]
+		next = comments[limit-1].ID
+	}
+
+	return comments, next, nil
+}
+
+// EditComment はコメントの本文を変更します。作成者以外はdomain.ErrPermissionDeniedになります
+func (c *commentInteractor) EditComment(ctx context.Context, noteID, id int64, body string) (*domain.Comment, error) {
+	comment, err := c.getOwnComment(ctx, noteID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := comment.Edit(body); err != nil {
+		return nil, err
+	}
+
+	updated, err := c.commentRepo.Update(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteComment はコメントを削除します。作成者以外はdomain.ErrPermissionDeniedになります
+func (c *commentInteractor) DeleteComment(ctx context.Context, noteID, id int64) error {
+	if _, err := c.getOwnComment(ctx, noteID, id); err != nil {
+		return err
+	}
+
+	if err := c.commentRepo.SoftDelete(ctx, noteID, id); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// getOwnComment はゴミ箱にないノートから、認証された主体が作成した削除されていないコメントを取得します
+func (c *commentInteractor) getOwnComment(ctx context.Context, noteID, id int64) (*domain.Comment, error) {
+	principal, err := requirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	comment, err := c.commentRepo.Get(ctx, noteID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment.IsDeleted() {
+		return nil, fmt.Errorf("comment with id %d: %w", id, domain.ErrCommentNotFound)
+	}
+	if !comment.IsAuthoredBy(principal) {
+		return nil, fmt.Errorf("comment with id %d is authored by another principal: %w", id, domain.ErrPermissionDenied)
+	}
+	return comment, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import "errors"
+
+var (
+	// ErrIdempotencyKeyReused は同じ冪等キーが異なるリクエスト内容で再利用された場合に返されます
+	ErrIdempotencyKeyReused = errors.New("idempotency key was reused with a different request")
+	// ErrIdempotencyInProgress は同じ冪等キーの先行リクエストが待機時間内に完了しなかった場合に返されます
+	ErrIdempotencyInProgress = errors.New("request with the same idempotency key is still in progress")
+	// ErrIdempotencyReservationLost は冪等キーの確保が期限切れなどにより他のリクエストのものになっていた場合に返されます
+	ErrIdempotencyReservationLost = errors.New("idempotency key is no longer reserved by this request")
+	// ErrBulkCreateAborted は全件作成するかまったく作成しない一括作成で、他のノートが作成できなかったために作成しなかったノートの結果に設定されます
+	ErrBulkCreateAborted = errors.New("note was not created because another note in the request failed")
+)
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// exportBatchSize はエクスポート時にNoteRepositoryから一度に読み込むノート数です
+const exportBatchSize = 200
+
+// exportInteractor はExportUsecaseインターフェースを実装します
+type exportInteractor struct {
+	noteRepo NoteRepository
+}
+
+// NewExportInteractor は新しいエクスポートインタラクターを作成します
+func NewExportInteractor(noteRepo NoteRepository) ExportUsecase {
+	return &exportInteractor{noteRepo: noteRepo}
+}
+
+// ExportNotes はfilterに一致するノートをバッチに分けて読み込み、順にfnに渡します
+// すべてのノートをメモリに載せないため、エクスポート中に変更されたノートは変更前後のどちらかの内容になります
+func (e *exportInteractor) ExportNotes(ctx context.Context, filter ExportFilter, fn func(*domain.Note) error) (int, error) {
+	normalized, err := domain.NormalizeTags(filter.Tags)
+	if err != nil {
+		return 0, err
+	}
+	filter.Tags = normalized
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && !filter.UpdatedFrom.Before(filter.UpdatedTo) {
+		return 0, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "updated_to", Description: "must be after updated_from"},
+		}}
+	}
+
+	count := 0
+	var afterID int64
+	for {
+		notes, err := e.noteRepo.ListForExport(ctx, filter, exportBatchSize, afterID)
+		if err != nil {
+			return count, fmt.Errorf("failed to list notes: %w", err)
+		}
+
+		for _, note := range notes {
+			if err := fn(note); err != nil {
+				return count, err
+			}
+			count++
+			afterID = note.ID
+		}
+
+		if len(notes) < exportBatchSize {
+			return count, nil
+		}
+	}
+}
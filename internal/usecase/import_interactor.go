@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// importInteractor はImportUsecaseインターフェースを実装します
+type importInteractor struct {
+	noteRepo    NoteRepository
+	noteUsecase NoteUsecase
+}
+
+// NewImportInteractor は新しいインポートインタラクターを作成します
+// ノートの検証と作成はnoteUsecaseの一括作成で行います
+func NewImportInteractor(noteRepo NoteRepository, noteUsecase NoteUsecase) ImportUsecase {
+	return &importInteractor{
+		noteRepo:    noteRepo,
+		noteUsecase: noteUsecase,
+	}
+}
+
+// ImportNotes はタイトルと本文のハッシュで重複を除いたノートを一括作成します
+// 重複の判定は作成前に行うため、同じノートを同時にインポートした場合は両方が作成されることがあります
+func (i *importInteractor) ImportNotes(ctx context.Context, inputs []NoteInput) ([]ImportResult, error) {
+	if len(inputs) > MaxBulkCreateNotes {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "notes", Description: fmt.Sprintf("must contain at most %d notes", MaxBulkCreateNotes)},
+		}}
+	}
+
+	hashes := make([]string, len(inputs))
+	// firsts は入力内で最初に現れたハッシュの位置です
+	firsts := make(map[string]int, len(inputs))
+	unique := make([]string, 0, len(inputs))
+	for j, input := range inputs {
+		hashes[j] = domain.ContentHash(input.Title, input.Content)
+		if _, ok := firsts[hashes[j]]; !ok {
+			firsts[hashes[j]] = j
+			unique = append(unique, hashes[j])
+		}
+	}
+
+	existing, err := i.noteRepo.FindIDsByContentHash(ctx, unique)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate notes: %w", err)
+	}
+
+	// 既存のノートと重複せず、入力内で最初に現れたノートだけを作成します
+	creates := make([]NoteInput, 0, len(unique))
+	indexes := make([]int, 0, len(unique))
+	for j, input := range inputs {
+		if _, ok := existing[hashes[j]]; ok || firsts[hashes[j]] != j {
+			continue
+		}
+		creates = append(creates, input)
+		indexes = append(indexes, j)
+	}
+
+	created, err := i.noteUsecase.BulkCreateNotes(ctx, creates, false)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ImportResult, len(inputs))
+	for k, result := range created {
+		results[indexes[k]] = ImportResult{Note: result.Note, Err: result.Err}
+	}
+	for j := range inputs {
+		if id, ok := existing[hashes[j]]; ok {
+			results[j].DuplicateOf = id
+			continue
+		}
+		first := firsts[hashes[j]]
+		if first == j {
+			continue
+		}
+		// 入力内の重複は最初のノートの結果に従います
+		if note := results[first].Note; note != nil {
+			results[j].DuplicateOf = note.ID
+		} else {
+			results[j].Err = results[first].Err
+		}
+	}
+	return results, nil
+}
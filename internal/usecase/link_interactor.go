@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// linkInteractor はLinkUsecaseインターフェースを実装します
+type linkInteractor struct {
+	noteRepo NoteRepository
+	linkRepo NoteLinkRepository
+}
+
+// NewLinkInteractor は新しいリンクインタラクターを作成します
+func NewLinkInteractor(noteRepo NoteRepository, linkRepo NoteLinkRepository) LinkUsecase {
+	return &linkInteractor{
+		noteRepo: noteRepo,
+		linkRepo: linkRepo,
+	}
+}
+
+// GetBacklinks はノートへのリンクを持つノートを取得します
+func (l *linkInteractor) GetBacklinks(ctx context.Context, noteID int64, limit int, beforeID int64) ([]*domain.Note, int64, error) {
+	note, err := l.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	limit = normalizePageSize(limit)
+
+	notes, err := l.linkRepo.ListBacklinks(ctx, note, limit+1, beforeID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list backlinks: %w", err)
+	}
+
+	var next int64
+	if len(notes) > limit {
+		notes = notes[:limit]
+		next = notes[limit-1].ID
+	}
+
+	return notes, next, nil
+}
+
+// GetOutgoingLinks はノートの発リンクを取得します
+func (l *linkInteractor) GetOutgoingLinks(ctx context.Context, noteID int64) ([]*domain.NoteLink, error) {
+	if _, err := l.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	links, err := l.linkRepo.ListOutgoing(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outgoing links: %w", err)
+	}
+	return links, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+	"time"
+)
+
+const (
+	// MaxBulkCreateNotes は1回の一括作成で作成できるノートの最大件数です
+	MaxBulkCreateNotes = 10000
+	// BulkCreateBatchSize は一部の失敗を許す一括作成で1つのトランザクションにまとめるノートの件数です
+	BulkCreateBatchSize = 500
+)
+
+// BulkCreateNotes は複数のノートを作成します
+// 先にすべてのノートを検証し、作成できるノートをBulkCreateBatchSize件ずつ（allOrNothingの場合は全件を1つの）トランザクションで作成します
+func (n *noteInteractor) BulkCreateNotes(ctx context.Context, inputs []NoteInput, allOrNothing bool) ([]BulkCreateResult, error) {
+	if len(inputs) > MaxBulkCreateNotes {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "notes", Description: fmt.Sprintf("must contain at most %d notes", MaxBulkCreateNotes)},
+		}}
+	}
+
+	results := make([]BulkCreateResult, len(inputs))
+	notes := make([]*domain.Note, 0, len(inputs))
+	indexes := make([]int, 0, len(inputs))
+	notebooks := make(map[int64]error)
+	now := time.Now()
+	for i, input := range inputs {
+		note, err := domain.NewNote(input.Title, input.Content, input.Tags, input.NotebookID)
+		if err == nil && !input.CreatedAt.IsZero() {
+			err = domain.ValidateCreatedAt(input.CreatedAt, now)
+			note.CreatedAt = input.CreatedAt
+		}
+		if err == nil {
+			// 同じノートブックを何度も確認しないよう結果を使い回します
+			var ok bool
+			if err, ok = notebooks[input.NotebookID]; !ok {
+				err = ensureNotebookExists(ctx, n.notebookRepo, input.NotebookID)
+				notebooks[input.NotebookID] = err
+			}
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		notes = append(notes, note)
+		indexes = append(indexes, i)
+	}
+
+	if allOrNothing {
+		if len(notes) < len(inputs) {
+			for _, i := range indexes {
+				results[i].Err = ErrBulkCreateAborted
+			}
+			return results, nil
+		}
+		created, err := n.bulkCreate(ctx, notes)
+		if err != nil {
+			return nil, err
+		}
+		for j, note := range created {
+			results[indexes[j]].Note = note
+		}
+		return results, nil
+	}
+
+	for start := 0; start < len(notes); start += BulkCreateBatchSize {
+		end := min(start+BulkCreateBatchSize, len(notes))
+		created, err := n.bulkCreate(ctx, notes[start:end])
+		if ctx.Err() != nil {
+			// 作成済みのバッチがあっても、クライアントが結果を受け取れないため中断します
+			return nil, ctx.Err()
+		}
+		for j := start; j < end; j++ {
+			if err != nil {
+				// バッチはまとめてロールバックされるため、バッチ内のすべてのノートが失敗になります
+				results[indexes[j]].Err = err
+				continue
+			}
+			results[indexes[j]].Note = created[j-start]
+		}
+	}
+	return results, nil
+}
+
+// bulkCreate はノートを1つのトランザクションで永続化し、検索インデックスに登録します
+// 一括作成したノートは読み取られるまでキャッシュしません
+func (n *noteInteractor) bulkCreate(ctx context.Context, notes []*domain.Note) ([]*domain.Note, error) {
+	created, err := n.noteRepo.BulkCreate(ctx, notes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notes: %w", err)
+	}
+	for _, note := range created {
+		indexNote(ctx, n.searchIndex, note)
+	}
+	return created, nil
+}
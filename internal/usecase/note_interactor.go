@@ -2,28 +2,200 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"go_test/internal/domain"
+	"time"
+)
+
+const (
+	// idempotencyLockTTL は処理中レコードの有効期限です（プロセス停止時にキーが残り続けないようにします）
+	idempotencyLockTTL = 30 * time.Second
+	// idempotencyRenewInterval は作成中に処理中レコードの有効期限を延長する間隔です
+	idempotencyRenewInterval = idempotencyLockTTL / 3
+	// idempotencyWaitTimeout は同じキーの先行リクエストの完了を待つ最大時間です
+	idempotencyWaitTimeout = 10 * time.Second
+	// idempotencyPollInterval は先行リクエストの完了を確認する間隔です
+	idempotencyPollInterval = 50 * time.Millisecond
+	// idempotencyStoreTimeout はリクエストのキャンセル後も続ける冪等キーストアへの操作1回あたりの最大時間です
+	idempotencyStoreTimeout = 5 * time.Second
+	// MaxBatchGetNotes は1回のBatchGetNotesで取得できるノートの最大件数です
+	MaxBatchGetNotes = 100
 )
 
 // noteInteractor はNoteUsecaseインターフェースを実装します
 type noteInteractor struct {
-	noteRepo NoteRepository
-	cache    Cache
+	noteRepo       NoteRepository
+	notebookRepo   NotebookRepository
+	cache          Cache
+	idempotency    IdempotencyStore
+	idempotencyTTL time.Duration
+	searchIndex    SearchIndex
 }
 
 // NewNoteInteractor は新しいノートインタラクターを作成します
-func NewNoteInteractor(noteRepo NoteRepository, cache Cache) NoteUsecase {
+// idempotencyTTLは冪等キーに紐づくレスポンスを保持する期間です
+// searchIndexがnilの場合、検索はNoteRepositoryの全文検索を使用します
+func NewNoteInteractor(noteRepo NoteRepository, notebookRepo NotebookRepository, cache Cache, idempotency IdempotencyStore, idempotencyTTL time.Duration, searchIndex SearchIndex) NoteUsecase {
 	return &noteInteractor{
-		noteRepo: noteRepo,
-		cache:    cache,
+		noteRepo:       noteRepo,
+		notebookRepo:   notebookRepo,
+		cache:          cache,
+		idempotency:    idempotency,
+		idempotencyTTL: idempotencyTTL,
+		searchIndex:    searchIndex,
 	}
 }
 
 // CreateNote は新しいノートを作成します
-func (n *noteInteractor) CreateNote(ctx context.Context, title, content string) (*domain.Note, error) {
-	note := domain.NewNote(title, content)
-	
+// idempotencyKeyが指定された場合、同じキーでの再送には最初に作成したノートを返します
+func (n *noteInteractor) CreateNote(ctx context.Context, title, content string, tags []string, notebookID int64, idempotencyKey string) (*domain.Note, error) {
+	note, err := domain.NewNote(title, content, tags, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureNotebookExists(ctx, n.notebookRepo, notebookID); err != nil {
+		return nil, err
+	}
+
+	if idempotencyKey == "" || n.idempotency == nil {
+		return n.createNote(ctx, note)
+	}
+
+	key := "idempotency:create_note:" + idempotencyKey
+	fingerprint := createNoteFingerprint(note)
+	owner, err := newIdempotencyOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, idempotencyWaitTimeout)
+	defer cancel()
+
+	for {
+		acquired, record, err := n.idempotency.Reserve(ctx, key, &IdempotencyRecord{Fingerprint: fingerprint, Owner: owner}, idempotencyLockTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+
+		if acquired {
+			return n.createNoteOnce(ctx, key, owner, fingerprint, note)
+		}
+
+		if record != nil && record.Fingerprint != fingerprint {
+			return nil, ErrIdempotencyKeyReused
+		}
+
+		// 先行リクエストの完了を待ちます
+		for record != nil && !record.Completed {
+			select {
+			case <-waitCtx.Done():
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				return nil, ErrIdempotencyInProgress
+			case <-time.After(idempotencyPollInterval):
+			}
+
+			record, err = n.idempotency.Get(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get idempotency record: %w", err)
+			}
+		}
+
+		// 先行リクエストが失敗してキーが解放された場合は確保からやり直します
+		if record == nil {
+			if waitCtx.Err() != nil {
+				return nil, ErrIdempotencyInProgress
+			}
+			continue
+		}
+
+		if record.Fingerprint != fingerprint {
+			return nil, ErrIdempotencyKeyReused
+		}
+		if record.Note == nil {
+			return nil, fmt.Errorf("idempotency record for key %q has no response", idempotencyKey)
+		}
+		return record.Note, nil
+	}
+}
+
+// createNoteOnce は確保済みの冪等キーでノートを作成し、結果を保存します
+func (n *noteInteractor) createNoteOnce(ctx context.Context, key, owner, fingerprint string, note *domain.Note) (*domain.Note, error) {
+	stopRenewing := n.renewReservation(ctx, key, owner)
+	createdNote, err := n.createNote(ctx, note)
+	stopRenewing()
+
+	// クライアントがタイムアウトしてctxがキャンセルされても、キーの解放と結果の保存は最後まで行います
+	// 結果を保存できないと、再送が処理中レコードの期限切れ後に重複してノートを作成します
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), idempotencyStoreTimeout)
+	defer cancel()
+
+	if err != nil {
+		// 失敗したリクエストは再試行できるようにキーを解放します
+		_ = n.idempotency.Release(storeCtx, key, owner)
+		return nil, err
+	}
+
+	record := &IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Completed:   true,
+		Note:        createdNote,
+	}
+	if err := n.idempotency.Complete(storeCtx, key, owner, record, n.idempotencyTTL); err != nil {
+		// ノートは作成済みのため、保存の失敗は操作を失敗させません
+		// キーを解放すると再送で同じノートが重複して作成されるため、処理中レコードは有効期限まで残します
+	}
+
+	return createdNote, nil
+}
+
+// renewReservation は返された関数が呼ばれるまで、処理中レコードの有効期限を一定間隔で延長します
+// 作成がidempotencyLockTTLより長くかかっても、後続のリクエストがキーを確保して重複して作成しないようにします
+// 作成はctxのキャンセル後も完了しうるため、延長はctxのキャンセルでは止めません
+func (n *noteInteractor) renewReservation(ctx context.Context, key, owner string) func() {
+	renewCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(idempotencyRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-renewCtx.Done():
+				return
+			case <-ticker.C:
+				callCtx, cancelCall := context.WithTimeout(renewCtx, idempotencyStoreTimeout)
+				renewed, err := n.idempotency.Renew(callCtx, key, owner, idempotencyLockTTL)
+				cancelCall()
+				if err == nil && !renewed {
+					// 既に他のリクエストのものになっているため延長をやめます
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// newIdempotencyOwner は処理中レコードの所有者を識別するランダムなトークンを生成します
+func newIdempotencyOwner() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency owner: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// createNote はノートを永続化し、キャッシュに保存します
+func (n *noteInteractor) createNote(ctx context.Context, note *domain.Note) (*domain.Note, error) {
 	createdNote, err := n.noteRepo.Create(ctx, note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create note: %w", err)
@@ -35,10 +207,26 @@ func (n *noteInteractor) CreateNote(ctx context.Context, title, content string)
 		// エラーをログに記録しますが、操作は失敗させません
 		// 実際のアプリケーションでは、ロガーを使用することを推奨します
 	}
+	indexNote(ctx, n.searchIndex, createdNote)
 
 	return createdNote, nil
 }
 
+// createNoteFingerprint は冪等キーの再利用を検出するためのリクエスト内容のハッシュを返します
+// タグは正規化後の値を使うため、表記揺れだけが異なる再送は同じリクエストとして扱います
+func createNoteFingerprint(note *domain.Note) string {
+	h := sha256.New()
+	h.Write([]byte(note.Title))
+	h.Write([]byte{0})
+	h.Write([]byte(note.Content))
+	for _, tag := range note.Tags {
+		h.Write([]byte{0})
+		h.Write([]byte(tag))
+	}
+	fmt.Fprintf(h, "\x00notebook:%d", note.NotebookID)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // GetNote はIDでノートを取得します
 func (n *noteInteractor) GetNote(ctx context.Context, id int64) (*domain.Note, error) {
 	// まずキャッシュから取得を試行
@@ -55,3 +243,228 @@ func (n *noteInteractor) GetNote(ctx context.Context, id int64) (*domain.Note, e
 
 	return note, nil
 }
+
+// BatchGetNotes は複数のノートを取得します
+// キャッシュをまとめて参照し、キャッシュにないノートのみをNoteRepositoryから1回で取得してキャッシュに保存します
+func (n *noteInteractor) BatchGetNotes(ctx context.Context, ids []int64) ([]*domain.Note, error) {
+	if len(ids) > MaxBatchGetNotes {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "ids", Description: fmt.Sprintf("must contain at most %d ids", MaxBatchGetNotes)},
+		}}
+	}
+
+	// 同じIDが複数回指定されても1回だけ取得します
+	unique := make([]int64, 0, len(ids))
+	found := make(map[int64]*domain.Note, len(ids))
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			found[id] = nil
+			unique = append(unique, id)
+		}
+	}
+
+	keys := make([]string, len(unique))
+	for i, id := range unique {
+		keys[i] = fmt.Sprintf("note:%d", id)
+	}
+	cached, err := n.cache.GetMulti(ctx, keys)
+	if err != nil {
+		// エラーをログに記録しますが、操作は失敗させません（すべてデータベースから取得します）
+		cached = make([]string, len(unique))
+	}
+
+	var misses []int64
+	for i, id := range unique {
+		var note domain.Note
+		if cached[i] != "" && json.Unmarshal([]byte(cached[i]), &note) == nil && note.ID == id && note.DeletedAt == nil {
+			found[id] = &note
+			continue
+		}
+		misses = append(misses, id)
+	}
+
+	if len(misses) > 0 {
+		notes, err := n.noteRepo.GetByIDs(ctx, misses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notes: %w", err)
+		}
+
+		backfill := make(map[string]interface{}, len(notes))
+		for _, note := range notes {
+			found[note.ID] = note
+			backfill[fmt.Sprintf("note:%d", note.ID)] = note
+		}
+		if err := n.cache.SetMulti(ctx, backfill); err != nil {
+			// エラーをログに記録しますが、操作は失敗させません
+		}
+	}
+
+	result := make([]*domain.Note, len(ids))
+	for i, id := range ids {
+		result[i] = found[id]
+	}
+	return result, nil
+}
+
+// UpdateNote はバージョンを確認してノートを更新します
+func (n *noteInteractor) UpdateNote(ctx context.Context, id int64, title, content string, tags []string, expectedVersion int64) (*domain.Note, error) {
+	note, err := n.noteRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	if note.Version != expectedVersion {
+		return nil, fmt.Errorf("note with id %d is at version %d, expected %d: %w", id, note.Version, expectedVersion, domain.ErrVersionConflict)
+	}
+
+	if err := note.Update(title, content); err != nil {
+		return nil, err
+	}
+	if tags != nil {
+		if err := note.SetTags(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedNote, err := n.noteRepo.Update(ctx, note, expectedVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	// 更新後のノートでキャッシュを置き換えます
+	cacheKey := fmt.Sprintf("note:%d", updatedNote.ID)
+	if err := n.cache.Set(ctx, cacheKey, updatedNote); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	indexNote(ctx, n.searchIndex, updatedNote)
+
+	return updatedNote, nil
+}
+
+// DeleteNote はノートをゴミ箱に移動し、キャッシュから削除します
+func (n *noteInteractor) DeleteNote(ctx context.Context, id, expectedVersion int64) (*domain.Note, error) {
+	note, err := n.noteRepo.SoftDelete(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	cacheKey := fmt.Sprintf("note:%d", id)
+	if err := n.cache.Delete(ctx, cacheKey); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	unindexNote(ctx, n.searchIndex, id)
+
+	return note, nil
+}
+
+// SearchNotes はノートを全文検索し、一致箇所を強調したスニペットを付けて返します
+func (n *noteInteractor) SearchNotes(ctx context.Context, query string, limit, offset int, tags domain.HighlightTags) ([]*domain.SearchHit, int, error) {
+	terms := domain.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, nil
+	}
+
+	limit = normalizePageSize(limit)
+
+	// 次のページの有無を判定するため1件多く取得します
+	var hits []*domain.SearchHit
+	var err error
+	if n.searchIndex != nil {
+		hits, err = n.searchIndex.Search(ctx, query, limit+1, offset)
+	} else {
+		hits, err = n.noteRepo.Search(ctx, query, limit+1, offset)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search notes: %w", err)
+	}
+
+	next := 0
+	if len(hits) > limit {
+		hits = hits[:limit]
+		next = offset + limit
+	}
+
+	for _, hit := range hits {
+		hit.TitleSnippet = domain.Highlight(hit.Note.Title, terms, tags)
+		hit.ContentSnippet = domain.Snippet(hit.Note.Content, terms, tags)
+	}
+
+	return hits, next, nil
+}
+
+// ListNotes はfilterに一致するノートを固定されたノートを先頭に新しい順で取得します
+func (n *noteInteractor) ListNotes(ctx context.Context, filter NoteFilter, limit int, after NoteCursor) ([]*domain.Note, NoteCursor, error) {
+	normalized, err := domain.NormalizeTags(filter.Tags)
+	if err != nil {
+		return nil, NoteCursor{}, err
+	}
+	filter.Tags = normalized
+	if err := ensureNotebookExists(ctx, n.notebookRepo, filter.NotebookID); err != nil {
+		return nil, NoteCursor{}, err
+	}
+
+	limit = normalizePageSize(limit)
+
+	notes, err := n.noteRepo.Find(ctx, filter, limit+1, after)
+	if err != nil {
+		return nil, NoteCursor{}, fmt.Errorf("failed to list notes: %w", err)
+	}
+
+	var next NoteCursor
+	if len(notes) > limit {
+		notes = notes[:limit]
+		last := notes[limit-1]
+		next = NoteCursor{Pinned: last.Pinned, ID: last.ID}
+	}
+
+	return notes, next, nil
+}
+
+// PinNote はノートの固定状態を変更します
+func (n *noteInteractor) PinNote(ctx context.Context, id int64, pinned bool) (*domain.Note, error) {
+	note, err := n.noteRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	if pinned {
+		if err := note.Pin(); err != nil {
+			return nil, err
+		}
+	} else {
+		note.Unpin()
+	}
+
+	return n.saveState(ctx, note)
+}
+
+// ArchiveNote はノートのアーカイブ状態を変更します
+func (n *noteInteractor) ArchiveNote(ctx context.Context, id int64, archived bool) (*domain.Note, error) {
+	note, err := n.noteRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	if archived {
+		note.Archive()
+	} else {
+		note.Unarchive()
+	}
+
+	return n.saveState(ctx, note)
+}
+
+// saveState はノートの固定とアーカイブの状態を保存し、キャッシュを置き換えます
+func (n *noteInteractor) saveState(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	updatedNote, err := n.noteRepo.SetState(ctx, note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update note state: %w", err)
+	}
+
+	cacheKey := fmt.Sprintf("note:%d", updatedNote.ID)
+	if err := n.cache.Set(ctx, cacheKey, updatedNote); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	indexNote(ctx, n.searchIndex, updatedNote)
+
+	return updatedNote, nil
+}
@@ -0,0 +1,215 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// fakeNoteRepository はCreateのみを実装するNoteRepositoryです
+type fakeNoteRepository struct {
+	usecase.NoteRepository
+	createErr   error
+	created     int
+	afterCreate func()
+}
+
+func (r *fakeNoteRepository) Create(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	r.created++
+	created := *note
+	created.ID = int64(r.created)
+	created.Version = 1
+	if r.afterCreate != nil {
+		r.afterCreate()
+	}
+	return &created, nil
+}
+
+// nopCache は何も保存しないCacheです
+type nopCache struct {
+	usecase.Cache
+}
+
+func (nopCache) Set(ctx context.Context, key string, value interface{}) error {
+	return nil
+}
+
+// fakeIdempotencyStore はRedisの冪等キーストアと同じく所有者を確認するメモリ上のIdempotencyStoreです
+// キャンセルされたctxでの更新はRedisクライアントと同じく失敗します
+type fakeIdempotencyStore struct {
+	mu          sync.Mutex
+	records     map[string]*usecase.IdempotencyRecord
+	completeErr error
+	released    []string
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{records: map[string]*usecase.IdempotencyRecord{}}
+}
+
+func (s *fakeIdempotencyStore) Reserve(ctx context.Context, key string, record *usecase.IdempotencyRecord, ttl time.Duration) (bool, *usecase.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[key]; ok {
+		c := *existing
+		return false, &c, nil
+	}
+	c := *record
+	s.records[key] = &c
+	return true, nil, nil
+}
+
+func (s *fakeIdempotencyStore) Get(ctx context.Context, key string) (*usecase.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[key]; ok {
+		c := *existing
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeIdempotencyStore) owned(key, owner string) bool {
+	r, ok := s.records[key]
+	return ok && !r.Completed && r.Owner == owner
+}
+
+func (s *fakeIdempotencyStore) Renew(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.owned(key, owner), nil
+}
+
+func (s *fakeIdempotencyStore) Complete(ctx context.Context, key, owner string, record *usecase.IdempotencyRecord, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completeErr != nil {
+		return s.completeErr
+	}
+	if !s.owned(key, owner) {
+		return usecase.ErrIdempotencyReservationLost
+	}
+	c := *record
+	s.records[key] = &c
+	return nil
+}
+
+func (s *fakeIdempotencyStore) Release(ctx context.Context, key, owner string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = append(s.released, owner)
+	if s.owned(key, owner) {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+func TestCreateNoteIdempotency(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeIdempotencyStore()
+	repo := &fakeNoteRepository{}
+	notes := usecase.NewNoteInteractor(repo, nil, nopCache{}, store, time.Hour, nil)
+
+	first, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1")
+	if err != nil {
+		t.Fatalf("CreateNote() error = %v", err)
+	}
+	again, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1")
+	if err != nil {
+		t.Fatalf("retried CreateNote() error = %v", err)
+	}
+	if again.ID != first.ID || repo.created != 1 {
+		t.Errorf("retry returned note %d after %d creates, want note %d created once", again.ID, repo.created, first.ID)
+	}
+
+	if _, err := notes.CreateNote(ctx, "other", "content", nil, 0, "key-1"); !errors.Is(err, usecase.ErrIdempotencyKeyReused) {
+		t.Errorf("CreateNote() with a different request error = %v, want %v", err, usecase.ErrIdempotencyKeyReused)
+	}
+
+	record, _ := store.Get(ctx, "idempotency:create_note:key-1")
+	if record == nil || !record.Completed || record.Owner != "" {
+		t.Errorf("completed record = %+v, want completed without an owner", record)
+	}
+}
+
+func TestCreateNoteReleasesReservationOnFailure(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeIdempotencyStore()
+	repo := &fakeNoteRepository{createErr: errors.New("database is down")}
+	notes := usecase.NewNoteInteractor(repo, nil, nopCache{}, store, time.Hour, nil)
+
+	if _, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1"); err == nil {
+		t.Fatal("CreateNote() error = nil, want the repository error")
+	}
+	if len(store.released) != 1 || store.released[0] == "" {
+		t.Fatalf("released = %q, want one release by the reserving owner", store.released)
+	}
+	if record, _ := store.Get(ctx, "idempotency:create_note:key-1"); record != nil {
+		t.Errorf("record after failure = %+v, want released", record)
+	}
+
+	// 解放されたキーで再試行できます
+	repo.createErr = nil
+	if _, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1"); err != nil {
+		t.Errorf("retried CreateNote() error = %v", err)
+	}
+}
+
+func TestCreateNoteKeepsReservationWhenCompleteFails(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeIdempotencyStore()
+	store.completeErr = errors.New("redis is down")
+	repo := &fakeNoteRepository{}
+	notes := usecase.NewNoteInteractor(repo, nil, nopCache{}, store, time.Hour, nil)
+
+	if _, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1"); err != nil {
+		t.Fatalf("CreateNote() error = %v, want the created note", err)
+	}
+	if len(store.released) != 0 {
+		t.Errorf("released = %q, want the reservation kept", store.released)
+	}
+	record, _ := store.Get(ctx, "idempotency:create_note:key-1")
+	if record == nil || record.Completed {
+		t.Errorf("record = %+v, want the pending reservation kept", record)
+	}
+}
+
+func TestCreateNoteCompletesAfterClientCancels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeIdempotencyStore()
+	// 挿入のコミット直後にクライアントがタイムアウトした状況を再現します
+	repo := &fakeNoteRepository{afterCreate: cancel}
+	notes := usecase.NewNoteInteractor(repo, nil, nopCache{}, store, time.Hour, nil)
+
+	first, err := notes.CreateNote(ctx, "title", "content", nil, 0, "key-1")
+	if err != nil {
+		t.Fatalf("CreateNote() error = %v, want the created note", err)
+	}
+
+	repo.afterCreate = nil
+	again, err := notes.CreateNote(context.Background(), "title", "content", nil, 0, "key-1")
+	if err != nil {
+		t.Fatalf("retried CreateNote() error = %v", err)
+	}
+	if again.ID != first.ID || repo.created != 1 {
+		t.Errorf("retry returned note %d after %d creates, want note %d created once", again.ID, repo.created, first.ID)
+	}
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// notebookInteractor はNotebookUsecaseインターフェースを実装します
+type notebookInteractor struct {
+	notebookRepo NotebookRepository
+	noteRepo     NoteRepository
+	cache        Cache
+	searchIndex  SearchIndex
+}
+
+// NewNotebookInteractor は新しいノートブックインタラクターを作成します
+func NewNotebookInteractor(notebookRepo NotebookRepository, noteRepo NoteRepository, cache Cache, searchIndex SearchIndex) NotebookUsecase {
+	return &notebookInteractor{
+		notebookRepo: notebookRepo,
+		noteRepo:     noteRepo,
+		cache:        cache,
+		searchIndex:  searchIndex,
+	}
+}
+
+// CreateNotebook は新しいノートブックを作成します
+func (nb *notebookInteractor) CreateNotebook(ctx context.Context, name string, parentID int64) (*domain.Notebook, error) {
+	notebook, err := domain.NewNotebook(name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureNotebookExists(ctx, nb.notebookRepo, parentID); err != nil {
+		return nil, err
+	}
+
+	created, err := nb.notebookRepo.Create(ctx, notebook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notebook: %w", err)
+	}
+	return created, nil
+}
+
+// GetNotebook はIDでノートブックを取得します
+func (nb *notebookInteractor) GetNotebook(ctx context.Context, id int64) (*domain.Notebook, error) {
+	notebook, err := nb.notebookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notebook: %w", err)
+	}
+	return notebook, nil
+}
+
+// UpdateNotebook はノートブックの名前と親を変更します
+// 親を変更する場合は新しい親の祖先をロックしたまま循環がないことを確認し、同時に行われる移動と競合しないようにします
+func (nb *notebookInteractor) UpdateNotebook(ctx context.Context, id int64, name string, parentID int64) (*domain.Notebook, error) {
+	err := nb.notebookRepo.Transaction(ctx, func(tx NotebookTx) error {
+		notebook, err := tx.GetForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get notebook: %w", err)
+		}
+
+		currentParentID := notebook.ParentID
+		if err := notebook.Update(name, parentID); err != nil {
+			return err
+		}
+
+		if parentID != currentParentID {
+			ancestors, err := tx.LockAncestors(ctx, parentID)
+			if err != nil {
+				return fmt.Errorf("failed to get parent notebook: %w", err)
+			}
+			if err := ensureNoCycle(id, parentID, ancestors); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Update(ctx, notebook); err != nil {
+			return fmt.Errorf("failed to update notebook: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := nb.notebookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notebook: %w", err)
+	}
+	return updated, nil
+}
+
+// ensureNoCycle は新しい親parentIDの祖先ancestorsにidが含まれないことを確認します
+func ensureNoCycle(id, parentID int64, ancestors []int64) error {
+	visited := make(map[int64]bool, len(ancestors))
+	for _, ancestor := range ancestors {
+		if ancestor == id {
+			return fmt.Errorf("notebook %d under %d: %w", id, parentID, domain.ErrNotebookCycle)
+		}
+		if visited[ancestor] {
+			// 既存のデータに循環がある場合も移動を許可しません
+			return fmt.Errorf("notebook %d has a cyclic ancestry: %w", ancestor, domain.ErrNotebookCycle)
+		}
+		visited[ancestor] = true
+	}
+	return nil
+}
+
+// DeleteNotebook は空のノートブックを削除します
+func (nb *notebookInteractor) DeleteNotebook(ctx context.Context, id int64) error {
+	if err := nb.notebookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete notebook: %w", err)
+	}
+	return nil
+}
+
+// ListNotebooks は子のノートブックを取得します
+func (nb *notebookInteractor) ListNotebooks(ctx context.Context, parentID int64, limit int, afterID int64) ([]*domain.Notebook, int64, error) {
+	if err := ensureNotebookExists(ctx, nb.notebookRepo, parentID); err != nil {
+		return nil, 0, err
+	}
+
+	limit = normalizePageSize(limit)
+
+	notebooks, err := nb.notebookRepo.ListChildren(ctx, parentID, limit+1, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list notebooks: %w", err)
+	}
+
+	var next int64
+	if len(notebooks) > limit {
+		notebooks = notebooks[:limit]
+		next = notebooks[limit-1].ID
+	}
+
+	return notebooks, next, nil
+}
+
+// MoveNote はノートをノートブックに移動します
+func (nb *notebookInteractor) MoveNote(ctx context.Context, noteID, notebookID int64) (*domain.Note, error) {
+	if err := ensureNotebookExists(ctx, nb.notebookRepo, notebookID); err != nil {
+		return nil, err
+	}
+
+	note, err := nb.noteRepo.Move(ctx, noteID, notebookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move note: %w", err)
+	}
+
+	cacheKey := fmt.Sprintf("note:%d", note.ID)
+	if err := nb.cache.Set(ctx, cacheKey, note); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	indexNote(ctx, nb.searchIndex, note)
+
+	return note, nil
+}
+
+// ensureNotebookExists はnotebookIDが0より大きい場合にノートブックが存在することを確認します
+func ensureNotebookExists(ctx context.Context, notebookRepo NotebookRepository, notebookID int64) error {
+	if notebookID == 0 {
+		return nil
+	}
+	if _, err := notebookRepo.GetByID(ctx, notebookID); err != nil {
+		return fmt.Errorf("failed to get notebook: %w", err)
+	}
+	return nil
+}
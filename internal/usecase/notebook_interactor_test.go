@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go_test/internal/domain"
+	"go_test/internal/usecase"
+)
+
+// fakeNotebookRepository はノートブックをメモリ上に保持するNotebookRepositoryです
+type fakeNotebookRepository struct {
+	usecase.NotebookRepository
+	notebooks map[int64]*domain.Notebook
+	locked    []int64
+}
+
+func newFakeNotebookRepository(notebooks ...*domain.Notebook) *fakeNotebookRepository {
+	r := &fakeNotebookRepository{notebooks: map[int64]*domain.Notebook{}}
+	for _, nb := range notebooks {
+		r.notebooks[nb.ID] = nb
+	}
+	return r
+}
+
+func (r *fakeNotebookRepository) GetByID(ctx context.Context, id int64) (*domain.Notebook, error) {
+	nb, ok := r.notebooks[id]
+	if !ok {
+		return nil, fmt.Errorf("notebook with id %d: %w", id, domain.ErrNotebookNotFound)
+	}
+	c := *nb
+	return &c, nil
+}
+
+func (r *fakeNotebookRepository) Transaction(ctx context.Context, fn func(tx usecase.NotebookTx) error) error {
+	return fn(fakeNotebookTx{r})
+}
+
+// fakeNotebookTx はロックしたIDを記録するNotebookTxです
+type fakeNotebookTx struct {
+	r *fakeNotebookRepository
+}
+
+func (t fakeNotebookTx) GetForUpdate(ctx context.Context, id int64) (*domain.Notebook, error) {
+	t.r.locked = append(t.r.locked, id)
+	return t.r.GetByID(ctx, id)
+}
+
+func (t fakeNotebookTx) LockAncestors(ctx context.Context, id int64) ([]int64, error) {
+	var ancestors []int64
+	visited := map[int64]bool{}
+	for current := id; current != 0; {
+		ancestors = append(ancestors, current)
+		if visited[current] {
+			break
+		}
+		visited[current] = true
+		nb, err := t.r.GetByID(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		t.r.locked = append(t.r.locked, current)
+		current = nb.ParentID
+	}
+	return ancestors, nil
+}
+
+func (t fakeNotebookTx) Update(ctx context.Context, notebook *domain.Notebook) error {
+	c := *notebook
+	t.r.notebooks[notebook.ID] = &c
+	return nil
+}
+
+func TestUpdateNotebookRejectsCycles(t *testing.T) {
+	// 1 → 2 → 3 の階層です
+	tree := func() *fakeNotebookRepository {
+		return newFakeNotebookRepository(
+			&domain.Notebook{ID: 1, Name: "root"},
+			&domain.Notebook{ID: 2, Name: "child", ParentID: 1},
+			&domain.Notebook{ID: 3, Name: "grandchild", ParentID: 2},
+			&domain.Notebook{ID: 4, Name: "other"},
+		)
+	}
+
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		wantErr  error
+	}{
+		{name: "under itself", id: 1, parentID: 1, wantErr: domain.ErrNotebookCycle},
+		{name: "under a child", id: 1, parentID: 2, wantErr: domain.ErrNotebookCycle},
+		{name: "under a grandchild", id: 1, parentID: 3, wantErr: domain.ErrNotebookCycle},
+		{name: "under a missing parent", id: 1, parentID: 99, wantErr: domain.ErrNotebookNotFound},
+		{name: "under an unrelated notebook", id: 2, parentID: 4},
+		{name: "to the top level", id: 3, parentID: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tree()
+			notebooks := usecase.NewNotebookInteractor(repo, nil, nopCache{}, nil)
+
+			got, err := notebooks.UpdateNotebook(context.Background(), tt.id, "renamed", tt.parentID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("UpdateNotebook() error = %v, want %v", err, tt.wantErr)
+				}
+				if repo.notebooks[tt.id].Name == "renamed" {
+					t.Error("notebook was updated despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateNotebook() error = %v", err)
+			}
+			if got.ParentID != tt.parentID || got.Name != "renamed" {
+				t.Errorf("UpdateNotebook() = %+v, want parent %d", got, tt.parentID)
+			}
+		})
+	}
+}
+
+func TestUpdateNotebookLocksNewAncestors(t *testing.T) {
+	repo := newFakeNotebookRepository(
+		&domain.Notebook{ID: 1, Name: "root"},
+		&domain.Notebook{ID: 2, Name: "child", ParentID: 1},
+		&domain.Notebook{ID: 3, Name: "other"},
+	)
+	notebooks := usecase.NewNotebookInteractor(repo, nil, nopCache{}, nil)
+
+	if _, err := notebooks.UpdateNotebook(context.Background(), 3, "other", 2); err != nil {
+		t.Fatalf("UpdateNotebook() error = %v", err)
+	}
+	// 移動するノートブック自身と、新しい親から最上位までの祖先をロックします
+	if fmt.Sprint(repo.locked) != fmt.Sprint([]int64{3, 2, 1}) {
+		t.Errorf("locked = %v, want [3 2 1]", repo.locked)
+	}
+}
+
+func TestUpdateNotebookRejectsCyclicAncestry(t *testing.T) {
+	// 既存のデータで2と3が互いを親にしています
+	repo := newFakeNotebookRepository(
+		&domain.Notebook{ID: 1, Name: "moved"},
+		&domain.Notebook{ID: 2, Name: "a", ParentID: 3},
+		&domain.Notebook{ID: 3, Name: "b", ParentID: 2},
+	)
+	notebooks := usecase.NewNotebookInteractor(repo, nil, nopCache{}, nil)
+
+	if _, err := notebooks.UpdateNotebook(context.Background(), 1, "moved", 2); !errors.Is(err, domain.ErrNotebookCycle) {
+		t.Errorf("UpdateNotebook() error = %v, want %v", err, domain.ErrNotebookCycle)
+	}
+}
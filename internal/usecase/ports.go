@@ -3,12 +3,248 @@ package usecase
 import (
 	"context"
 	"go_test/internal/domain"
+	"io"
+	"time"
 )
 
 // NoteUsecase はノートユースケースのインターフェースを定義します
 type NoteUsecase interface {
-	CreateNote(ctx context.Context, title, content string) (*domain.Note, error)
+	// CreateNote はノートを作成します。notebookIDが0の場合はどのノートブックにも属さないノートを作成します
+	CreateNote(ctx context.Context, title, content string, tags []string, notebookID int64, idempotencyKey string) (*domain.Note, error)
 	GetNote(ctx context.Context, id int64) (*domain.Note, error)
+	// BatchGetNotes は複数のノートをidsと同じ順序で返します。存在しないかゴミ箱にあるノートの位置はnilになります
+	BatchGetNotes(ctx context.Context, ids []int64) ([]*domain.Note, error)
+	// UpdateNote はexpectedVersionと現在のバージョンが一致する場合にのみノートを更新します
+	// tagsがnilの場合はタグを変更せず、空のスライスの場合はすべてのタグを外します
+	UpdateNote(ctx context.Context, id int64, title, content string, tags []string, expectedVersion int64) (*domain.Note, error)
+	// DeleteNote はノートをゴミ箱に移動します。expectedVersionが0の場合はバージョンを確認しません
+	DeleteNote(ctx context.Context, id, expectedVersion int64) (*domain.Note, error)
+	// SearchNotes はタイトルと本文を全文検索し、関連度の高い順に返します
+	// 続きがある場合は次のページのoffsetを返し、ない場合は0を返します
+	SearchNotes(ctx context.Context, query string, limit, offset int, tags domain.HighlightTags) ([]*domain.SearchHit, int, error)
+	// ListNotes はfilterに一致するノートを固定されたノートを先頭に新しい順で返します
+	// afterのIDが0より大きい場合はその位置より後のノートのみを返します
+	// 続きがある場合は次のページのカーソルを返し、ない場合はIDが0のカーソルを返します
+	ListNotes(ctx context.Context, filter NoteFilter, limit int, after NoteCursor) ([]*domain.Note, NoteCursor, error)
+	// PinNote はノートを固定（pinnedがfalseの場合は固定を解除）します。バージョンは変更しません
+	PinNote(ctx context.Context, id int64, pinned bool) (*domain.Note, error)
+	// ArchiveNote はノートをアーカイブ（archivedがfalseの場合はアーカイブを解除）します。バージョンは変更しません
+	ArchiveNote(ctx context.Context, id int64, archived bool) (*domain.Note, error)
+	// BulkCreateNotes は複数のノートを作成し、inputsと同じ順序で1件ごとの結果を返します
+	// allOrNothingがtrueの場合は1件でも作成できないノートがあればどのノートも作成しません
+	// falseの場合は作成できるノートのみを作成します。1件ごとの失敗は結果のErrに設定し、エラーとしては返しません
+	BulkCreateNotes(ctx context.Context, inputs []NoteInput, allOrNothing bool) ([]BulkCreateResult, error)
+}
+
+// NoteInput はノートの一括作成で作成する1件のノートの内容を表します
+type NoteInput struct {
+	Title   string
+	Content string
+	Tags    []string
+	// NotebookID が0の場合はどのノートブックにも属さないノートを作成します
+	NotebookID int64
+	// CreatedAt はインポートしたノートの元の作成日時です。ゼロ値の場合は作成した日時になります
+	CreatedAt time.Time
+}
+
+// BulkCreateResult はノートの一括作成の1件分の結果を表します。NoteとErrのいずれか一方が設定されます
+type BulkCreateResult struct {
+	Note *domain.Note
+	Err  error
+}
+
+// NoteCursor はノート一覧のページング位置を表します（固定されたノートが先頭に並ぶため固定状態とIDの組で表します）
+type NoteCursor struct {
+	Pinned bool
+	ID     int64
+}
+
+// NoteFilter はノート一覧の絞り込み条件を表します
+type NoteFilter struct {
+	// Tags はノートに付いている必要があるタグです（すべてを含むノートに一致します）
+	Tags []string
+	// NotebookID が0より大きい場合はそのノートブックのノートに絞り込みます
+	NotebookID int64
+	// IncludeDescendants がtrueの場合はNotebookIDの子孫のノートブックのノートも含めます
+	IncludeDescendants bool
+	// IncludeArchived がtrueの場合はアーカイブされたノートも含めます
+	IncludeArchived bool
+}
+
+// NotebookUsecase はノートブックユースケースのインターフェースを定義します
+type NotebookUsecase interface {
+	// CreateNotebook はノートブックを作成します。parentIDが0の場合は最上位に作成します
+	CreateNotebook(ctx context.Context, name string, parentID int64) (*domain.Notebook, error)
+	GetNotebook(ctx context.Context, id int64) (*domain.Notebook, error)
+	// UpdateNotebook はノートブックの名前と親を変更します
+	// 自身またはその子孫の下に移動しようとした場合はdomain.ErrNotebookCycleを返します
+	UpdateNotebook(ctx context.Context, id int64, name string, parentID int64) (*domain.Notebook, error)
+	// DeleteNotebook は空のノートブックを削除します
+	DeleteNotebook(ctx context.Context, id int64) error
+	// ListNotebooks は親がparentIDのノートブックを作成順に返します。parentIDが0の場合は最上位のノートブックを返します
+	// 続きがある場合は次のページのafterIDを返し、ない場合は0を返します
+	ListNotebooks(ctx context.Context, parentID int64, limit int, afterID int64) ([]*domain.Notebook, int64, error)
+	// MoveNote はノートをノートブックに移動します。notebookIDが0の場合はノートブックから外します
+	MoveNote(ctx context.Context, noteID, notebookID int64) (*domain.Note, error)
+}
+
+// TagUsecase はタグユースケースのインターフェースを定義します
+type TagUsecase interface {
+	// ListTags はゴミ箱にないノートに付いているタグをノート数の多い順に返します
+	ListTags(ctx context.Context) ([]*domain.TagCount, error)
+}
+
+// TrashUsecase はゴミ箱ユースケースのインターフェースを定義します
+type TrashUsecase interface {
+	ListTrash(ctx context.Context, limit int, beforeID int64) ([]*domain.Note, int64, error)
+	RestoreNote(ctx context.Context, id int64) (*domain.Note, error)
+	PurgeNote(ctx context.Context, id int64) error
+	// PurgeExpired は保持期間を過ぎたゴミ箱のノートを完全に削除し、削除件数を返します
+	PurgeExpired(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// NoteRevisionUsecase はノートのリビジョン履歴ユースケースのインターフェースを定義します
+type NoteRevisionUsecase interface {
+	// ListRevisions は新しい順にリビジョンを返します。beforeVersionが0より大きい場合はそれより前のリビジョンのみを返します
+	// 続きがある場合は次のページのbeforeVersionを返し、ない場合は0を返します
+	ListRevisions(ctx context.Context, noteID int64, limit int, beforeVersion int64) ([]*domain.NoteRevision, int64, error)
+	GetRevision(ctx context.Context, noteID, version int64) (*domain.NoteRevision, error)
+	// RestoreRevision はリビジョンの内容をノートの新しいバージョンとして復元します
+	// expectedVersionが0の場合は現在のバージョンに対して復元します
+	RestoreRevision(ctx context.Context, noteID, version, expectedVersion int64) (*domain.Note, error)
+	// DiffRevisions はfromVersionとtoVersionの差分を計算します。toVersionが0の場合は現在の内容と比較します
+	DiffRevisions(ctx context.Context, noteID, fromVersion, toVersion int64, granularity domain.DiffGranularity, contextSize int) (*domain.NoteDiff, error)
+}
+
+// SearchIndexUsecase は検索インデックスの管理ユースケースのインターフェースを定義します
+type SearchIndexUsecase interface {
+	// Reindex はNoteRepositoryのノートからインデックスを再構築し、登録したノート数を返します
+	Reindex(ctx context.Context) (int, error)
+}
+
+// LinkUsecase はノート間のリンクユースケースのインターフェースを定義します
+type LinkUsecase interface {
+	// GetBacklinks はノートにIDまたはタイトルでリンクしているノートを新しい順に返します
+	// 続きがある場合は次のページのbeforeIDを返し、ない場合は0を返します
+	GetBacklinks(ctx context.Context, noteID int64, limit int, beforeID int64) ([]*domain.Note, int64, error)
+	// GetOutgoingLinks はノートの本文に含まれるリンクを参照先を解決して返します
+	GetOutgoingLinks(ctx context.Context, noteID int64) ([]*domain.NoteLink, error)
+}
+
+// TemplateUsecase はノートテンプレートユースケースのインターフェースを定義します
+type TemplateUsecase interface {
+	CreateTemplate(ctx context.Context, name, titleTemplate, contentTemplate string) (*domain.NoteTemplate, error)
+	GetTemplate(ctx context.Context, id int64) (*domain.NoteTemplate, error)
+	UpdateTemplate(ctx context.Context, id int64, name, titleTemplate, contentTemplate string) (*domain.NoteTemplate, error)
+	DeleteTemplate(ctx context.Context, id int64) error
+	// ListTemplates はテンプレートを作成順に返します。続きがある場合は次のページのafterIDを返し、ない場合は0を返します
+	ListTemplates(ctx context.Context, limit int, afterID int64) ([]*domain.NoteTemplate, int64, error)
+	// CreateNoteFromTemplate はテンプレートに現在日時、作成者（認証された主体）、変数を当てはめてノートを作成します
+	// タグ、ノートブック、冪等キーはCreateNoteと同じように扱います
+	CreateNoteFromTemplate(ctx context.Context, templateID int64, vars map[string]string, tags []string, notebookID int64, idempotencyKey string) (*domain.Note, error)
+}
+
+// AttachmentUsecase は添付ファイルユースケースのインターフェースを定義します
+type AttachmentUsecase interface {
+	// UploadAttachment はrの内容をノートに添付します。上限サイズを超えた場合はdomain.ErrAttachmentTooLargeを返します
+	UploadAttachment(ctx context.Context, noteID int64, filename string, r io.Reader) (*domain.Attachment, error)
+	// DownloadAttachment は添付ファイルのメタデータと内容を返します。呼び出し側で内容を閉じる必要があります
+	DownloadAttachment(ctx context.Context, noteID, id int64) (*domain.Attachment, io.ReadCloser, error)
+	ListAttachments(ctx context.Context, noteID int64) ([]*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, noteID, id int64) error
+	// SweepBlobs はどの添付ファイルからも参照されていない内容のうちgraceより前に保存されたものを削除し、削除件数を返します
+	SweepBlobs(ctx context.Context, grace time.Duration) (int, error)
+}
+
+// CommentUsecase はノートのコメントユースケースのインターフェースを定義します
+// 追加・編集・削除にはコンテキストに認証された主体が必要で、編集・削除は作成者のみが行えます
+type CommentUsecase interface {
+	// AddComment はノートにコメントを追加します。parentIDが0より大きい場合はそのコメントへの返信になります
+	AddComment(ctx context.Context, noteID, parentID int64, body string) (*domain.Comment, error)
+	// ListComments はparentIDへの返信（0の場合はノートへの直接のコメント）を古い順に返します
+	// 続きがある場合は次のページのafterIDを返し、ない場合は0を返します
+	ListComments(ctx context.Context, noteID, parentID int64, limit int, afterID int64) ([]*domain.Comment, int64, error)
+	EditComment(ctx context.Context, noteID, id int64, body string) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, noteID, id int64) error
+}
+
+// NoteEventRelayUsecase は送信待ちのノートの変更イベントを発行するユースケースのインターフェースを定義します
+type NoteEventRelayUsecase interface {
+	// RelayPending は送信予定時刻を過ぎたイベントを発行し、発行した件数とデッドレターにした件数を返します
+	RelayPending(ctx context.Context) (published, deadLettered int, err error)
+}
+
+// WebhookUsecase はWebhookの登録と送信記録の参照のユースケースのインターフェースを定義します
+type WebhookUsecase interface {
+	// CreateWebhook はWebhookを登録します。eventTypesが空の場合はすべてのイベントを通知します
+	CreateWebhook(ctx context.Context, url string, eventTypes []domain.NoteEventType, secret string) (*domain.Webhook, error)
+	GetWebhook(ctx context.Context, id int64) (*domain.Webhook, error)
+	// UpdateWebhook はWebhookを更新します。secretが空の場合はシークレットを変更しません
+	UpdateWebhook(ctx context.Context, id int64, url string, eventTypes []domain.NoteEventType, secret string, active bool) (*domain.Webhook, error)
+	// DeleteWebhook はWebhookとその送信記録を削除します
+	DeleteWebhook(ctx context.Context, id int64) error
+	// ListWebhooks はWebhookを登録順に返します。続きがある場合は次のページのafterIDを返し、ない場合は0を返します
+	ListWebhooks(ctx context.Context, limit int, afterID int64) ([]*domain.Webhook, int64, error)
+	// ListWebhookDeliveries はWebhookの送信記録を新しい順に返します。続きがある場合は次のページのbeforeIDを返し、ない場合は0を返します
+	ListWebhookDeliveries(ctx context.Context, webhookID int64, limit int, beforeID int64) ([]*domain.WebhookDelivery, int64, error)
+}
+
+// WebhookDispatchUsecase はノートの変更イベントをWebhookで送信するユースケースのインターフェースを定義します
+type WebhookDispatchUsecase interface {
+	// EnqueueDeliveries はイベントを通知対象の有効なWebhookごとの送信待ちとして記録し、記録した件数を返します
+	// 同じイベントを複数回記録しようとしても、Webhookごとに1件しか記録しません
+	EnqueueDeliveries(ctx context.Context, event *domain.NoteEvent) (int, error)
+	// DeliverDue は送信予定時刻を過ぎた送信待ちを送信し、成功した件数と再送を打ち切った件数を返します
+	DeliverDue(ctx context.Context) (succeeded, abandoned int, err error)
+}
+
+// ExportUsecase はノートのエクスポートのユースケースのインターフェースを定義します
+type ExportUsecase interface {
+	// ExportNotes はfilterに一致するゴミ箱にないノートをIDの昇順でfnに渡し、渡した件数を返します
+	// fnがエラーを返した場合はそこで中断してそのエラーを返します
+	ExportNotes(ctx context.Context, filter ExportFilter, fn func(*domain.Note) error) (int, error)
+}
+
+// ImportUsecase はノートのインポートのユースケースのインターフェースを定義します
+type ImportUsecase interface {
+	// ImportNotes はノートを作成し、入力と同じ順に結果を返します
+	// タイトルと本文が既存のノートまたは先に取り込んだノートと同じ場合は作成せずに重複として報告します
+	ImportNotes(ctx context.Context, inputs []NoteInput) ([]ImportResult, error)
+}
+
+// ImportResult はノートのインポートの1件分の結果を表します
+// 作成した場合はNote、重複の場合はDuplicateOf、失敗した場合はErrが設定されます
+type ImportResult struct {
+	Note *domain.Note
+	// DuplicateOf は同じタイトルと本文を持つ既存のノートのIDです
+	DuplicateOf int64
+	Err         error
+}
+
+// ExportFilter はエクスポートするノートの絞り込み条件を表します
+type ExportFilter struct {
+	// UpdatedFrom がゼロ値でない場合は、この日時以降に更新されたノートに絞り込みます
+	UpdatedFrom time.Time
+	// UpdatedTo がゼロ値でない場合は、この日時より前に更新されたノートに絞り込みます
+	UpdatedTo time.Time
+	// Tags はノートに付いている必要があるタグです（すべてを含むノートに一致します）
+	Tags []string
+}
+
+// WatchUsecase はノートの変更の監視ユースケースのインターフェースを定義します
+type WatchUsecase interface {
+	// WatchNotes はノートの変更イベントを発生順にfnに渡し、ctxがキャンセルされるかfnがエラーを返すまで戻りません
+	// noteIDsが空でない場合はそのノートのイベントのみを渡します
+	// afterEventIDが指定された場合はそのイベントより後のイベントから、空の場合は呼び出し以降のイベントから渡します
+	WatchNotes(ctx context.Context, noteIDs []int64, afterEventID string, fn func(*domain.NoteEvent) error) error
+}
+
+// RenderUsecase はノートの本文をHTMLに変換するユースケースのインターフェースを定義します
+type RenderUsecase interface {
+	// RenderNote はノートを取得し、本文をサニタイズ済みのHTMLに変換して返します
+	RenderNote(ctx context.Context, id int64) (*domain.Note, string, error)
+	// RenderContent は取得済みのノートの本文をサニタイズ済みのHTMLに変換します
+	RenderContent(ctx context.Context, note *domain.Note) (string, error)
 }
 
 // PingUsecase はピングユースケースのインターフェースを定義します
@@ -19,16 +255,290 @@ type PingUsecase interface {
 // NoteRepository はノートリポジトリのインターフェースを定義します
 type NoteRepository interface {
 	Create(ctx context.Context, note *domain.Note) (*domain.Note, error)
+	// BulkCreate は複数のノートを1つのトランザクションで作成し、作成したノートを同じ順序で返します
+	BulkCreate(ctx context.Context, notes []*domain.Note) ([]*domain.Note, error)
 	GetByID(ctx context.Context, id int64) (*domain.Note, error)
+	// GetByIDs はゴミ箱にないノートを1回のクエリで取得します。存在しないIDは結果に含まれず、順序は保証しません
+	GetByIDs(ctx context.Context, ids []int64) ([]*domain.Note, error)
+	// Update はバージョンがexpectedVersionと一致する場合にノートを更新し、バージョンを1つ進めます
+	// 一致しない場合はdomain.ErrVersionConflictを返します
+	Update(ctx context.Context, note *domain.Note, expectedVersion int64) (*domain.Note, error)
+	// GetIncludingTrashed はゴミ箱にあるノートも含めて取得します（GetByIDはゴミ箱のノートを返しません）
+	GetIncludingTrashed(ctx context.Context, id int64) (*domain.Note, error)
+	// SoftDelete はノートをゴミ箱に移動します。expectedVersionが0より大きい場合はバージョンを確認します
+	SoftDelete(ctx context.Context, id, expectedVersion int64) (*domain.Note, error)
+	// Restore はゴミ箱にあるノートを元に戻します。ゴミ箱にない場合はdomain.ErrNoteNotTrashedを返します
+	Restore(ctx context.Context, id int64) (*domain.Note, error)
+	ListTrashed(ctx context.Context, limit int, beforeID int64) ([]*domain.Note, error)
+	// Purge はゴミ箱にあるノートを完全に削除します。ゴミ箱にない場合はdomain.ErrNoteNotTrashedを返します
+	Purge(ctx context.Context, id int64) error
+	// PurgeTrashedOlderThan はゴミ箱に移動されてからageを超えたノートを最大limit件完全に削除し、削除件数を返します
+	PurgeTrashedOlderThan(ctx context.Context, age time.Duration, limit int) (int64, error)
+	// Search はゴミ箱にないノートを全文検索し、関連度の高い順にScoreを設定したSearchHitを返します
+	Search(ctx context.Context, query string, limit, offset int) ([]*domain.SearchHit, error)
+	// List はゴミ箱にないノートをIDの昇順で取得します。afterIDより大きいIDのみを返します
+	List(ctx context.Context, limit int, afterID int64) ([]*domain.Note, error)
+	// ListForExport はfilterに一致するゴミ箱にないノート（アーカイブされたノートを含む）をIDの昇順で取得します
+	// afterIDより大きいIDのみを返します
+	ListForExport(ctx context.Context, filter ExportFilter, limit int, afterID int64) ([]*domain.Note, error)
+	// FindIDsByContentHash はタイトルと本文のハッシュ（domain.ContentHash）が一致するゴミ箱にないノートのIDをハッシュごとに取得します
+	FindIDsByContentHash(ctx context.Context, hashes []string) (map[string]int64, error)
+	// Find はfilterに一致するゴミ箱にないノートを固定されたノートを先頭にIDの降順で取得します
+	// afterのIDが0より大きい場合はその位置より後のノートのみを返します
+	Find(ctx context.Context, filter NoteFilter, limit int, after NoteCursor) ([]*domain.Note, error)
+	// Move はノートのノートブックを変更します。バージョンは変更しません
+	Move(ctx context.Context, id, notebookID int64) (*domain.Note, error)
+	// SetState はノートの固定とアーカイブの状態を保存します。バージョンは変更しません
+	SetState(ctx context.Context, note *domain.Note) (*domain.Note, error)
+}
+
+// NotebookRepository はノートブックリポジトリのインターフェースを定義します
+type NotebookRepository interface {
+	Create(ctx context.Context, notebook *domain.Notebook) (*domain.Notebook, error)
+	// GetByID はノートブックを取得します。存在しない場合はdomain.ErrNotebookNotFoundを返します
+	GetByID(ctx context.Context, id int64) (*domain.Notebook, error)
+	// Transaction はトランザクション内でfnを呼び出し、fnがnilを返した場合はコミット、エラーを返した場合はロールバックします
+	// デッドロックで中断された場合はfnを最初から呼び直すことがあるため、fnは再実行できる必要があります
+	Transaction(ctx context.Context, fn func(tx NotebookTx) error) error
+	// Delete はノートブックを削除します。子のノートブックまたはゴミ箱にないノートがある場合はdomain.ErrNotebookNotEmptyを返します
+	Delete(ctx context.Context, id int64) error
+	// ListChildren は親がparentIDのノートブックをIDの昇順で取得します。afterIDより大きいIDのみを返します
+	ListChildren(ctx context.Context, parentID int64, limit int, afterID int64) ([]*domain.Notebook, error)
+}
+
+// NotebookTx はNotebookRepository.Transactionのトランザクション内で行うノートブックの操作を定義します
+// 取得したノートブックの行はコミットまたはロールバックするまでロックされます
+type NotebookTx interface {
+	// GetForUpdate はノートブックを行ロックして取得します。存在しない場合はdomain.ErrNotebookNotFoundを返します
+	GetForUpdate(ctx context.Context, id int64) (*domain.Notebook, error)
+	// LockAncestors はidのノートブックから親を順にたどり、たどったノートブックを行ロックしてそのIDを返します
+	// 先頭はid自身で、最上位のノートブックまでを返します。idが0の場合は空を返します
+	// 既存のデータに循環がある場合は、再び現れたIDを最後の要素としてたどるのをやめます
+	LockAncestors(ctx context.Context, id int64) ([]int64, error)
+	// Update はノートブックの名前と親を保存します
+	Update(ctx context.Context, notebook *domain.Notebook) error
+}
+
+// TagRepository はタグの読み取りのインターフェースを定義します
+// ノートとタグの関連付けはNoteRepositoryがノートの変更と同じトランザクションで行います
+type TagRepository interface {
+	// ListWithCounts はゴミ箱にないノートに付いているタグとノート数をノート数の降順、名前の昇順で返します
+	ListWithCounts(ctx context.Context) ([]*domain.TagCount, error)
+}
+
+// SearchIndex は全文検索インデックスのインターフェースを定義します
+// インデックスはNoteRepositoryから再構築できる派生データです
+type SearchIndex interface {
+	// Index はノートを追加または置き換えます
+	Index(ctx context.Context, note *domain.Note) error
+	// Remove はノートをインデックスから削除します。存在しない場合は何もしません
+	Remove(ctx context.Context, id int64) error
+	// Search はクエリに一致するノートを関連度の高い順にScoreを設定して返します
+	Search(ctx context.Context, query string, limit, offset int) ([]*domain.SearchHit, error)
+	// Reset はインデックスを空にします
+	Reset(ctx context.Context) error
+}
+
+// NoteRevisionRepository はノートリビジョンの読み取りのインターフェースを定義します
+// リビジョンの書き込みはNoteRepositoryがノートの変更と同じトランザクションで行います
+type NoteRevisionRepository interface {
+	List(ctx context.Context, noteID int64, limit int, beforeVersion int64) ([]*domain.NoteRevision, error)
+	Get(ctx context.Context, noteID, version int64) (*domain.NoteRevision, error)
+}
+
+// NoteTemplateRepository はノートテンプレートリポジトリのインターフェースを定義します
+type NoteTemplateRepository interface {
+	Create(ctx context.Context, t *domain.NoteTemplate) (*domain.NoteTemplate, error)
+	// GetByID はテンプレートを取得します。存在しない場合はdomain.ErrTemplateNotFoundを返します
+	GetByID(ctx context.Context, id int64) (*domain.NoteTemplate, error)
+	Update(ctx context.Context, t *domain.NoteTemplate) (*domain.NoteTemplate, error)
+	Delete(ctx context.Context, id int64) error
+	// List はテンプレートをIDの昇順で取得します。afterIDより大きいIDのみを返します
+	List(ctx context.Context, limit int, afterID int64) ([]*domain.NoteTemplate, error)
+}
+
+// AttachmentRepository は添付ファイルのメタデータのリポジトリのインターフェースを定義します
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *domain.Attachment) (*domain.Attachment, error)
+	// Get はノートの添付ファイルを取得します。存在しない場合はdomain.ErrAttachmentNotFoundを返します
+	Get(ctx context.Context, noteID, id int64) (*domain.Attachment, error)
+	// ListByNote はノートの添付ファイルをIDの昇順で取得します
+	ListByNote(ctx context.Context, noteID int64) ([]*domain.Attachment, error)
+	Delete(ctx context.Context, noteID, id int64) error
+	// ReferencedDigests はdigestsのうち添付ファイルから参照されているものを返します
+	ReferencedDigests(ctx context.Context, digests []string) (map[string]bool, error)
+}
+
+// CommentRepository はコメントのリポジトリのインターフェースを定義します
+type CommentRepository interface {
+	Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	// Get はノートのコメントを削除済みのものも含めて取得します。存在しない場合はdomain.ErrCommentNotFoundを返します
+	Get(ctx context.Context, noteID, id int64) (*domain.Comment, error)
+	// Update はコメントの本文を更新します
+	Update(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	// SoftDelete はコメントの本文を空にして削除済みにします
+	SoftDelete(ctx context.Context, noteID, id int64) error
+	// List はparentIDへの返信（0の場合はノートへの直接のコメント）を削除済みのものも含めてIDの昇順で取得します
+	// afterIDより大きいIDのみを返します
+	List(ctx context.Context, noteID, parentID int64, limit int, afterID int64) ([]*domain.Comment, error)
+}
+
+// Authenticator はリクエストの認証情報から主体を特定するインターフェースを定義します
+type Authenticator interface {
+	// Authenticate はトークンに対応する主体を返します。無効なトークンの場合はdomain.ErrUnauthenticatedを返します
+	Authenticate(ctx context.Context, token string) (*domain.Principal, error)
+}
+
+// OutboxEntry は送信待ちのノートの変更イベントを表します
+type OutboxEntry struct {
+	ID    int64
+	Event *domain.NoteEvent
+	// Attempts はこれまでに送信に失敗した回数です
+	Attempts int
+}
+
+// NoteOutboxRepository はノートの変更イベントの送信待ちキュー（トランザクショナルアウトボックス）のインターフェースを定義します
+// イベントの記録はNoteRepositoryがノートの変更と同じトランザクションで行います
+type NoteOutboxRepository interface {
+	// ClaimPending は送信予定時刻を過ぎたイベントを古い順に最大limit件取得します
+	// 取得したイベントはleaseの間、他のリレーから取得されません。期限までに送信済みにならなければ再び取得されます
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*OutboxEntry, error)
+	// MarkPublished は送信済みのイベントを削除します
+	MarkPublished(ctx context.Context, id int64) error
+	// MarkFailed は失敗回数を増やし、retryAfter後に再送するよう記録します
+	MarkFailed(ctx context.Context, id int64, cause string, retryAfter time.Duration) error
+	// MarkDeadLettered は再送を打ち切ったイベントを原因とともに残します
+	MarkDeadLettered(ctx context.Context, id int64, cause string) error
+}
+
+// NoteEventPublisher はノートの変更イベントを発行するインターフェースを定義します
+type NoteEventPublisher interface {
+	// Publish はイベントを発行します。イベントのIDは発行時に割り当てられます
+	Publish(ctx context.Context, event *domain.NoteEvent) error
+}
+
+// NoteEventSubscriber はノートの変更イベントを購読するインターフェースを定義します
+type NoteEventSubscriber interface {
+	// Subscribe はafterIDより後のイベント（空の場合は購読開始以降のイベント）を発生順にfnに渡します
+	// ctxがキャンセルされるかfnがエラーを返すまで戻りません
+	// 受信が発行に追いつかない場合はdomain.ErrWatchLaggingを返します
+	// afterIDが不正な場合は*domain.ValidationErrorを、afterIDより後のイベントが既に削除されている場合はdomain.ErrWatchCursorExpiredを返します
+	Subscribe(ctx context.Context, afterID string, fn func(*domain.NoteEvent) error) error
+}
+
+// NoteEventConsumer はコンシューマーグループとしてノートの変更イベントを消費するインターフェースを定義します
+type NoteEventConsumer interface {
+	// Consume はgroupにまだ配信されていないイベントを発生順にfnに渡し、ctxがキャンセルされるまで戻りません
+	// 同じgroupの各イベントはいずれか1つのconsumerにのみ渡されます
+	// fnがエラーを返したイベントや、処理中にconsumerが停止したイベントは、しばらくしてから再びfnに渡されます（at-least-once）
+	Consume(ctx context.Context, group, consumer string, fn func(*domain.NoteEvent) error) error
+}
+
+// WebhookRepository はWebhookの登録のリポジトリのインターフェースを定義します
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *domain.Webhook) (*domain.Webhook, error)
+	GetByID(ctx context.Context, id int64) (*domain.Webhook, error)
+	Update(ctx context.Context, webhook *domain.Webhook) (*domain.Webhook, error)
+	// Delete はWebhookとその送信記録を削除します
+	Delete(ctx context.Context, id int64) error
+	// List はWebhookをIDの昇順で取得します。afterIDより大きいIDのみを返します
+	List(ctx context.Context, limit int, afterID int64) ([]*domain.Webhook, error)
+	// ListActive は有効なWebhookをすべて取得します
+	ListActive(ctx context.Context) ([]*domain.Webhook, error)
+}
+
+// WebhookDeliveryRepository はWebhookの送信待ちと送信記録のリポジトリのインターフェースを定義します
+type WebhookDeliveryRepository interface {
+	// Enqueue はイベントをWebhookごとの送信待ちとして記録し、新しく記録した件数を返します
+	// 同じWebhookとイベントの組み合わせが既に記録されている場合は何もしません
+	Enqueue(ctx context.Context, webhookIDs []int64, event *domain.NoteEvent) (int, error)
+	// ClaimDue は送信予定時刻を過ぎた送信待ちを古い順に最大limit件取得します
+	// 取得した送信待ちはleaseの間、他のワーカーから取得されません。期限までに結果が記録されなければ再び取得されます
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error)
+	// MarkSucceeded は送信回数を増やし、送信に成功したことを記録します
+	MarkSucceeded(ctx context.Context, id int64, statusCode int) error
+	// MarkFailed は送信回数を増やして失敗の原因を記録し、retryAfter後に再送するよう記録します
+	MarkFailed(ctx context.Context, id int64, statusCode int, cause string, retryAfter time.Duration) error
+	// MarkAbandoned は送信回数を増やして失敗の原因を記録し、再送を打ち切ります
+	MarkAbandoned(ctx context.Context, id int64, statusCode int, cause string) error
+	// List はWebhookの送信記録をIDの降順で取得します。beforeIDが0より大きい場合はそれより小さいIDのみを返します
+	List(ctx context.Context, webhookID int64, limit int, beforeID int64) ([]*domain.WebhookDelivery, error)
+}
+
+// WebhookSender はWebhookのリクエストを送信するインターフェースを定義します
+type WebhookSender interface {
+	// Send はbodyをurlにPOSTし、応答のHTTPステータスコードを返します
+	// 応答を受け取れなかった場合のみエラーを返し、2xx以外の応答はエラーとしません
+	Send(ctx context.Context, url string, header map[string]string, body []byte) (statusCode int, err error)
+}
+
+// BlobStore はファイルの内容をSHA256のダイジェストをキーとして保存するストレージのインターフェースを定義します
+type BlobStore interface {
+	// Put はrの内容を保存し、ダイジェスト（16進数）とサイズを返します
+	// maxSizeバイトを超えた場合は保存せずにdomain.ErrAttachmentTooLargeを返します
+	Put(ctx context.Context, r io.Reader, maxSize int64) (digest string, size int64, err error)
+	// Open は内容を読み取ります。存在しない場合はdomain.ErrAttachmentNotFoundを返します
+	Open(ctx context.Context, digest string) (io.ReadCloser, error)
+	// DeleteIfStale は保存日時がcutoffより前で、かつunreferencedがtrueを返す場合に内容を削除し、削除したかを返します
+	// 保存日時の確認から削除までをPutと排他的に行い、確認中に同じ内容が保存し直された場合は削除しません
+	// 存在しない場合は何もせずfalseを返します
+	DeleteIfStale(ctx context.Context, digest string, cutoff time.Time, unreferenced func() (bool, error)) (bool, error)
+	// Walk は保存されているすべての内容のダイジェストと保存日時をfnに渡します
+	Walk(ctx context.Context, fn func(digest string, storedAt time.Time) error) error
+}
+
+// NoteLinkRepository はノート間のリンクの読み取りのインターフェースを定義します
+// リンクの書き込みはNoteRepositoryがノートの変更と同じトランザクションで行います
+type NoteLinkRepository interface {
+	// ListBacklinks はnoteにリンクしているゴミ箱にないノートをIDの降順で取得します。beforeIDが0より大きい場合はそれより小さいIDのみを返します
+	ListBacklinks(ctx context.Context, note *domain.Note, limit int, beforeID int64) ([]*domain.Note, error)
+	// ListOutgoing はノートの発リンクを出現順に取得します
+	ListOutgoing(ctx context.Context, noteID int64) ([]*domain.NoteLink, error)
+}
+
+// MarkdownRenderer はMarkdownをHTMLに変換するインターフェースを定義します
+// 返すHTMLはそのままブラウザに表示できるようサニタイズ済みである必要があります
+type MarkdownRenderer interface {
+	Render(content string) (string, error)
 }
 
 // Cache はキャッシュ操作のインターフェースを定義します
 type Cache interface {
 	Set(ctx context.Context, key string, value interface{}) error
 	Get(ctx context.Context, key string) (string, error)
+	// GetMulti は複数のキーの値をkeysと同じ順序で返します。存在しないキーの値は空文字列です
+	GetMulti(ctx context.Context, keys []string) ([]string, error)
+	// SetMulti は複数のキーに値をまとめて設定します
+	SetMulti(ctx context.Context, values map[string]interface{}) error
 	Delete(ctx context.Context, key string) error
 }
 
+// IdempotencyRecord は冪等キーに紐づく処理状態と最初のレスポンスを表します
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	// Owner は処理中レコードを確保したリクエストを識別するランダムなトークンです
+	Owner     string       `json:"owner,omitempty"`
+	Completed bool         `json:"completed"`
+	Note      *domain.Note `json:"note,omitempty"`
+}
+
+// IdempotencyStore は冪等キーの保存操作のインターフェースを定義します
+// Reserve以外の操作は、キーがownerの処理中レコードである場合にのみ不可分に行います
+type IdempotencyStore interface {
+	// Reserve はキーが未使用の場合に処理中レコードを保存してtrueを返します
+	// 既に存在する場合は保存済みのレコードとfalseを返します
+	Reserve(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) (bool, *IdempotencyRecord, error)
+	// Get はキーのレコードを取得します。存在しない場合はnilを返します
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+	// Renew はownerの処理中レコードの有効期限をttlに延長します。ownerのものでない場合はfalseを返します
+	Renew(ctx context.Context, key, owner string, ttl time.Duration) (bool, error)
+	// Complete はownerの処理中レコードを完了したレコードで上書きします
+	// ownerのものでない場合は上書きせずにErrIdempotencyReservationLostを返します
+	Complete(ctx context.Context, key, owner string, record *IdempotencyRecord, ttl time.Duration) error
+	// Release はownerの処理中レコードを削除し、後続のリクエストが再試行できるようにします。ownerのものでない場合は何もしません
+	Release(ctx context.Context, key, owner string) error
+}
+
 // SQLPinger はSQLピング操作のインターフェースを定義します
 type SQLPinger interface {
 	Ping(ctx context.Context) error
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"go_test/internal/domain"
+)
+
+// principalKey はコンテキストに主体を格納するキーです
+type principalKey struct{}
+
+// WithPrincipal は認証された主体を格納したコンテキストを返します
+func WithPrincipal(ctx context.Context, p *domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext はコンテキストに格納された主体を返します。認証されていない場合はfalseを返します
+func PrincipalFromContext(ctx context.Context) (*domain.Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*domain.Principal)
+	return p, ok && p != nil
+}
+
+// requirePrincipal はコンテキストの主体を返します。認証されていない場合はdomain.ErrUnauthenticatedを返します
+func requirePrincipal(ctx context.Context) (*domain.Principal, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrUnauthenticated
+	}
+	return p, nil
+}
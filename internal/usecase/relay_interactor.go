@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// relayBatchSize は一度に取得する送信待ちのイベントの件数です
+	relayBatchSize = 100
+	// relayLease は取得したイベントを他のリレーから隠す期間です。この間に送信済みにならなければ再送されます
+	relayLease = 30 * time.Second
+	// relayInitialBackoff は最初の再送までの待機時間です。失敗するたびに倍になります
+	relayInitialBackoff = time.Second
+	// relayMaxBackoff は再送までの待機時間の上限です
+	relayMaxBackoff = 5 * time.Minute
+)
+
+// noteEventRelayInteractor はNoteEventRelayUsecaseインターフェースを実装します
+type noteEventRelayInteractor struct {
+	outboxRepo  NoteOutboxRepository
+	publisher   NoteEventPublisher
+	maxAttempts int
+}
+
+// NewNoteEventRelayInteractor は新しいイベントリレーインタラクターを作成します
+// maxAttempts回送信に失敗したイベントはデッドレターとして再送を打ち切ります
+func NewNoteEventRelayInteractor(outboxRepo NoteOutboxRepository, publisher NoteEventPublisher, maxAttempts int) NoteEventRelayUsecase {
+	return &noteEventRelayInteractor{
+		outboxRepo:  outboxRepo,
+		publisher:   publisher,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// RelayPending は送信待ちのイベントを古い順に発行します
+// 発行後に送信済みにできなかったイベントはリース期限後に再送されるため、同じイベントが複数回発行されることがあります（at-least-once）
+// 発行に失敗した場合は発行先の障害の可能性が高いため、残りのイベントの失敗回数を増やさないようそのバッチを打ち切ります
+// 打ち切ったイベントはリース期限後に再び取得されます
+func (r *noteEventRelayInteractor) RelayPending(ctx context.Context) (int, int, error) {
+	published, deadLettered := 0, 0
+	for {
+		entries, err := r.outboxRepo.ClaimPending(ctx, relayBatchSize, relayLease)
+		if err != nil {
+			return published, deadLettered, fmt.Errorf("failed to claim note events: %w", err)
+		}
+
+		for _, entry := range entries {
+			if err := r.publisher.Publish(ctx, entry.Event); err != nil {
+				attempts := entry.Attempts + 1
+				if attempts >= r.maxAttempts {
+					if err := r.outboxRepo.MarkDeadLettered(ctx, entry.ID, err.Error()); err != nil {
+						return published, deadLettered, err
+					}
+					deadLettered++
+					continue
+				}
+				if err := r.outboxRepo.MarkFailed(ctx, entry.ID, err.Error(), exponentialBackoff(attempts, relayInitialBackoff, relayMaxBackoff)); err != nil {
+					return published, deadLettered, err
+				}
+				return published, deadLettered, fmt.Errorf("failed to publish note event %d: %w", entry.ID, err)
+			}
+
+			if err := r.outboxRepo.MarkPublished(ctx, entry.ID); err != nil {
+				return published, deadLettered, err
+			}
+			published++
+		}
+
+		if len(entries) < relayBatchSize {
+			return published, deadLettered, nil
+		}
+	}
+}
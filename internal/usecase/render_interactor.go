@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// renderInteractor はRenderUsecaseインターフェースを実装します
+type renderInteractor struct {
+	noteRepo NoteRepository
+	cache    Cache
+	renderer MarkdownRenderer
+}
+
+// NewRenderInteractor は新しいレンダリングインタラクターを作成します
+func NewRenderInteractor(noteRepo NoteRepository, cache Cache, renderer MarkdownRenderer) RenderUsecase {
+	return &renderInteractor{
+		noteRepo: noteRepo,
+		cache:    cache,
+		renderer: renderer,
+	}
+}
+
+// RenderNote はIDでノートを取得してHTMLに変換します
+func (r *renderInteractor) RenderNote(ctx context.Context, id int64) (*domain.Note, string, error) {
+	note, err := r.noteRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get note: %w", err)
+	}
+
+	html, err := r.RenderContent(ctx, note)
+	if err != nil {
+		return nil, "", err
+	}
+	return note, html, nil
+}
+
+// RenderContent はノートの本文をHTMLに変換します
+// 変換結果はノートIDとバージョンをキーにキャッシュし、同じバージョンの再変換を省略します
+func (r *renderInteractor) RenderContent(ctx context.Context, note *domain.Note) (string, error) {
+	cacheKey := renderCacheKey(note)
+	if cachedValue, err := r.cache.Get(ctx, cacheKey); err == nil && cachedValue != "" {
+		var html string
+		if err := json.Unmarshal([]byte(cachedValue), &html); err == nil {
+			return html, nil
+		}
+	}
+
+	html, err := r.renderer.Render(note.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to render note: %w", err)
+	}
+
+	if err := r.cache.Set(ctx, cacheKey, html); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+
+	return html, nil
+}
+
+// renderCacheKey はノートの変換結果のキャッシュキーを返します
+// バージョンを含めるため、ノートが更新されると古い変換結果は参照されなくなります
+func renderCacheKey(note *domain.Note) string {
+	return fmt.Sprintf("note:%d:v%d:html", note.ID, note.Version)
+}
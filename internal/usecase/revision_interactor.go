@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+const (
+	// defaultPageSize はページサイズが指定されなかった場合の件数です
+	defaultPageSize = 20
+	// maxPageSize はページサイズの上限です
+	maxPageSize = 100
+)
+
+// noteRevisionInteractor はNoteRevisionUsecaseインターフェースを実装します
+type noteRevisionInteractor struct {
+	noteRepo     NoteRepository
+	revisionRepo NoteRevisionRepository
+	cache        Cache
+	searchIndex  SearchIndex
+}
+
+// NewNoteRevisionInteractor は新しいノートリビジョンインタラクターを作成します
+func NewNoteRevisionInteractor(noteRepo NoteRepository, revisionRepo NoteRevisionRepository, cache Cache, searchIndex SearchIndex) NoteRevisionUsecase {
+	return &noteRevisionInteractor{
+		noteRepo:     noteRepo,
+		revisionRepo: revisionRepo,
+		cache:        cache,
+		searchIndex:  searchIndex,
+	}
+}
+
+// ListRevisions はノートのリビジョンを新しい順に取得します
+func (r *noteRevisionInteractor) ListRevisions(ctx context.Context, noteID int64, limit int, beforeVersion int64) ([]*domain.NoteRevision, int64, error) {
+	if _, err := r.noteRepo.GetByID(ctx, noteID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get note: %w", err)
+	}
+
+	limit = normalizePageSize(limit)
+
+	// 次のページの有無を判定するため1件多く取得します
+	revisions, err := r.revisionRepo.List(ctx, noteID, limit+1, beforeVersion)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	var next int64
+	if len(revisions) > limit {
+		revisions = revisions[:limit]
+		next = revisions[limit-1].Version
+	}
+
+	return revisions, next, nil
+}
+
+// GetRevision は指定されたバージョンのリビジョンを取得します
+func (r *noteRevisionInteractor) GetRevision(ctx context.Context, noteID, version int64) (*domain.NoteRevision, error) {
+	revision, err := r.revisionRepo.Get(ctx, noteID, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// RestoreRevision はリビジョンの内容でノートを更新します
+func (r *noteRevisionInteractor) RestoreRevision(ctx context.Context, noteID, version, expectedVersion int64) (*domain.Note, error) {
+	revision, err := r.revisionRepo.Get(ctx, noteID, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	note, err := r.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	if expectedVersion == 0 {
+		expectedVersion = note.Version
+	}
+	if note.Version != expectedVersion {
+		return nil, fmt.Errorf("note with id %d is at version %d, expected %d: %w", noteID, note.Version, expectedVersion, domain.ErrVersionConflict)
+	}
+
+	if err := note.Update(revision.Title, revision.Content); err != nil {
+		return nil, err
+	}
+
+	restoredNote, err := r.noteRepo.Update(ctx, note, expectedVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore revision: %w", err)
+	}
+
+	// 復元後のノートでキャッシュを置き換えます
+	cacheKey := fmt.Sprintf("note:%d", restoredNote.ID)
+	if err := r.cache.Set(ctx, cacheKey, restoredNote); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	indexNote(ctx, r.searchIndex, restoredNote)
+
+	return restoredNote, nil
+}
+
+// DiffRevisions は2つのリビジョン、またはリビジョンと現在の内容の差分を計算します
+func (r *noteRevisionInteractor) DiffRevisions(ctx context.Context, noteID, fromVersion, toVersion int64, granularity domain.DiffGranularity, contextSize int) (*domain.NoteDiff, error) {
+	from, err := r.revisionRepo.Get(ctx, noteID, fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	var to *domain.NoteRevision
+	if toVersion == 0 {
+		note, err := r.noteRepo.GetByID(ctx, noteID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get note: %w", err)
+		}
+		to = &domain.NoteRevision{
+			NoteID:    note.ID,
+			Version:   note.Version,
+			Title:     note.Title,
+			Content:   note.Content,
+			CreatedAt: note.UpdatedAt,
+		}
+	} else {
+		to, err = r.revisionRepo.Get(ctx, noteID, toVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get revision: %w", err)
+		}
+	}
+
+	hunks := domain.ComputeDiff(from.Content, to.Content, granularity, contextSize)
+	oldLabel := fmt.Sprintf("a/note/%d@v%d", noteID, from.Version)
+	newLabel := fmt.Sprintf("b/note/%d@v%d", noteID, to.Version)
+
+	return &domain.NoteDiff{
+		NoteID:      noteID,
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		OldTitle:    from.Title,
+		NewTitle:    to.Title,
+		Granularity: granularity,
+		Hunks:       hunks,
+		Unified:     domain.FormatUnifiedDiff(oldLabel, newLabel, hunks, granularity),
+	}, nil
+}
+
+// normalizePageSize はページサイズを既定値と上限の範囲に収めます
+func normalizePageSize(size int) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
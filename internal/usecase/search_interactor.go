@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// reindexBatchSize は再構築時にNoteRepositoryから一度に読み込むノート数です
+const reindexBatchSize = 500
+
+// searchIndexInteractor はSearchIndexUsecaseインターフェースを実装します
+type searchIndexInteractor struct {
+	noteRepo    NoteRepository
+	searchIndex SearchIndex
+}
+
+// NewSearchIndexInteractor は新しい検索インデックスインタラクターを作成します
+func NewSearchIndexInteractor(noteRepo NoteRepository, searchIndex SearchIndex) SearchIndexUsecase {
+	return &searchIndexInteractor{
+		noteRepo:    noteRepo,
+		searchIndex: searchIndex,
+	}
+}
+
+// Reindex はインデックスを空にしてからゴミ箱にないすべてのノートを登録します
+func (s *searchIndexInteractor) Reindex(ctx context.Context) (int, error) {
+	if err := s.searchIndex.Reset(ctx); err != nil {
+		return 0, fmt.Errorf("failed to reset search index: %w", err)
+	}
+
+	count := 0
+	var afterID int64
+	for {
+		notes, err := s.noteRepo.List(ctx, reindexBatchSize, afterID)
+		if err != nil {
+			return count, fmt.Errorf("failed to list notes: %w", err)
+		}
+
+		for _, note := range notes {
+			if err := s.searchIndex.Index(ctx, note); err != nil {
+				return count, fmt.Errorf("failed to index note %d: %w", note.ID, err)
+			}
+			count++
+			afterID = note.ID
+		}
+
+		if len(notes) < reindexBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// indexNote は検索インデックスが設定されている場合にノートを登録します
+// インデックスは再構築可能な派生データのため、失敗しても操作は失敗させません
+func indexNote(ctx context.Context, searchIndex SearchIndex, note *domain.Note) {
+	if searchIndex == nil {
+		return
+	}
+	if err := searchIndex.Index(ctx, note); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+}
+
+// unindexNote は検索インデックスが設定されている場合にノートを削除します
+func unindexNote(ctx context.Context, searchIndex SearchIndex, id int64) {
+	if searchIndex == nil {
+		return
+	}
+	if err := searchIndex.Remove(ctx, id); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+)
+
+// tagInteractor はTagUsecaseインターフェースを実装します
+type tagInteractor struct {
+	tagRepo TagRepository
+}
+
+// NewTagInteractor は新しいタグインタラクターを作成します
+func NewTagInteractor(tagRepo TagRepository) TagUsecase {
+	return &tagInteractor{
+		tagRepo: tagRepo,
+	}
+}
+
+// ListTags はタグとノート数の一覧を取得します
+func (t *tagInteractor) ListTags(ctx context.Context) ([]*domain.TagCount, error) {
+	tags, err := t.tagRepo.ListWithCounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+	"time"
+)
+
+// templateInteractor はTemplateUsecaseインターフェースを実装します
+type templateInteractor struct {
+	templateRepo NoteTemplateRepository
+	noteUsecase  NoteUsecase
+	now          func() time.Time
+}
+
+// NewTemplateInteractor は新しいテンプレートインタラクターを作成します
+// テンプレートから生成したノートはnoteUsecaseで作成します
+func NewTemplateInteractor(templateRepo NoteTemplateRepository, noteUsecase NoteUsecase) TemplateUsecase {
+	return &templateInteractor{
+		templateRepo: templateRepo,
+		noteUsecase:  noteUsecase,
+		now:          time.Now,
+	}
+}
+
+// CreateTemplate は新しいテンプレートを作成します
+func (t *templateInteractor) CreateTemplate(ctx context.Context, name, titleTemplate, contentTemplate string) (*domain.NoteTemplate, error) {
+	tmpl, err := domain.NewNoteTemplate(name, titleTemplate, contentTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.templateRepo.Create(ctx, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create note template: %w", err)
+	}
+	return created, nil
+}
+
+// GetTemplate はIDでテンプレートを取得します
+func (t *templateInteractor) GetTemplate(ctx context.Context, id int64) (*domain.NoteTemplate, error) {
+	tmpl, err := t.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// UpdateTemplate はテンプレートを更新します
+func (t *templateInteractor) UpdateTemplate(ctx context.Context, id int64, name, titleTemplate, contentTemplate string) (*domain.NoteTemplate, error) {
+	tmpl, err := t.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note template: %w", err)
+	}
+
+	if err := tmpl.Update(name, titleTemplate, contentTemplate); err != nil {
+		return nil, err
+	}
+
+	updated, err := t.templateRepo.Update(ctx, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update note template: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteTemplate はテンプレートを削除します。作成済みのノートには影響しません
+func (t *templateInteractor) DeleteTemplate(ctx context.Context, id int64) error {
+	if err := t.templateRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete note template: %w", err)
+	}
+	return nil
+}
+
+// ListTemplates はテンプレートの一覧を取得します
+func (t *templateInteractor) ListTemplates(ctx context.Context, limit int, afterID int64) ([]*domain.NoteTemplate, int64, error) {
+	limit = normalizePageSize(limit)
+
+	templates, err := t.templateRepo.List(ctx, limit+1, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list note templates: %w", err)
+	}
+
+	var next int64
+	if len(templates) > limit {
+		templates = templates[:limit]
+		next = templates[limit-1].ID
+	}
+
+	return templates, next, nil
+}
+
+// CreateNoteFromTemplate はテンプレートからノートを作成します
+// 作成者は認証された主体のIDです（認証されていない場合は空になります）
+func (t *templateInteractor) CreateNoteFromTemplate(ctx context.Context, templateID int64, vars map[string]string, tags []string, notebookID int64, idempotencyKey string) (*domain.Note, error) {
+	tmpl, err := t.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get note template: %w", err)
+	}
+
+	var author string
+	if p, ok := PrincipalFromContext(ctx); ok {
+		author = p.ID
+	}
+
+	title, content, err := tmpl.Render(ctx, domain.TemplateData{
+		Now:    t.now(),
+		Author: author,
+		Vars:   vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.noteUsecase.CreateNote(ctx, title, content, tags, notebookID, idempotencyKey)
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go_test/internal/domain"
+	"time"
+)
+
+// purgeBatchSize は期限切れのノートを一度に削除する件数です
+const purgeBatchSize = 100
+
+// trashInteractor はTrashUsecaseインターフェースを実装します
+type trashInteractor struct {
+	noteRepo    NoteRepository
+	cache       Cache
+	searchIndex SearchIndex
+}
+
+// NewTrashInteractor は新しいゴミ箱インタラクターを作成します
+func NewTrashInteractor(noteRepo NoteRepository, cache Cache, searchIndex SearchIndex) TrashUsecase {
+	return &trashInteractor{
+		noteRepo:    noteRepo,
+		cache:       cache,
+		searchIndex: searchIndex,
+	}
+}
+
+// ListTrash はゴミ箱にあるノートを取得します
+func (t *trashInteractor) ListTrash(ctx context.Context, limit int, beforeID int64) ([]*domain.Note, int64, error) {
+	limit = normalizePageSize(limit)
+
+	notes, err := t.noteRepo.ListTrashed(ctx, limit+1, beforeID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	var next int64
+	if len(notes) > limit {
+		notes = notes[:limit]
+		next = notes[limit-1].ID
+	}
+
+	return notes, next, nil
+}
+
+// RestoreNote はゴミ箱にあるノートを元に戻します
+func (t *trashInteractor) RestoreNote(ctx context.Context, id int64) (*domain.Note, error) {
+	note, err := t.noteRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore note: %w", err)
+	}
+
+	cacheKey := fmt.Sprintf("note:%d", note.ID)
+	if err := t.cache.Set(ctx, cacheKey, note); err != nil {
+		// エラーをログに記録しますが、操作は失敗させません
+	}
+	indexNote(ctx, t.searchIndex, note)
+
+	return note, nil
+}
+
+// PurgeNote はゴミ箱にあるノートを完全に削除します
+func (t *trashInteractor) PurgeNote(ctx context.Context, id int64) error {
+	if err := t.noteRepo.Purge(ctx, id); err != nil {
+		return fmt.Errorf("failed to purge note: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeExpired は保持期間を過ぎたノートをバッチに分けて完全に削除します
+func (t *trashInteractor) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	var total int64
+	for {
+		purged, err := t.noteRepo.PurgeTrashedOlderThan(ctx, retention, purgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to purge expired notes: %w", err)
+		}
+		total += purged
+		if purged < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
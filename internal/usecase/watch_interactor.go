@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"go_test/internal/domain"
+)
+
+// watchInteractor はWatchUsecaseインターフェースを実装します
+type watchInteractor struct {
+	subscriber NoteEventSubscriber
+}
+
+// NewWatchInteractor は新しい監視インタラクターを作成します
+func NewWatchInteractor(subscriber NoteEventSubscriber) WatchUsecase {
+	return &watchInteractor{subscriber: subscriber}
+}
+
+// WatchNotes はノートの変更イベントを購読し、対象のノートのイベントのみをfnに渡します
+func (w *watchInteractor) WatchNotes(ctx context.Context, noteIDs []int64, afterEventID string, fn func(*domain.NoteEvent) error) error {
+	var targets map[int64]bool
+	if len(noteIDs) > 0 {
+		targets = make(map[int64]bool, len(noteIDs))
+		for _, id := range noteIDs {
+			targets[id] = true
+		}
+	}
+
+	return w.subscriber.Subscribe(ctx, afterEventID, func(event *domain.NoteEvent) error {
+		if targets != nil && !targets[event.NoteID] {
+			return nil
+		}
+		return fn(event)
+	})
+}
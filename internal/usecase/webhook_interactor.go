@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_test/internal/domain"
+	"strconv"
+	"time"
+)
+
+const (
+	// webhookDeliveryBatchSize は一度に取得する送信待ちの件数です
+	webhookDeliveryBatchSize = 10
+	// webhookDeliveryLease は取得した送信待ちを他のワーカーから隠す期間です
+	// 1バッチ分の送信がすべてタイムアウトしても期限内に終わるよう、送信のタイムアウトより十分長くします
+	webhookDeliveryLease = 5 * time.Minute
+	// webhookInitialBackoff は最初の再送までの待機時間です。失敗するたびに倍になります
+	webhookInitialBackoff = 10 * time.Second
+	// webhookMaxBackoff は再送までの待機時間の上限です
+	webhookMaxBackoff = time.Hour
+)
+
+// webhookInteractor はWebhookUsecaseインターフェースを実装します
+type webhookInteractor struct {
+	webhookRepo  WebhookRepository
+	deliveryRepo WebhookDeliveryRepository
+}
+
+// NewWebhookInteractor は新しいWebhookインタラクターを作成します
+// Webhookの管理には認証された主体が必要です
+func NewWebhookInteractor(webhookRepo WebhookRepository, deliveryRepo WebhookDeliveryRepository) WebhookUsecase {
+	return &webhookInteractor{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// CreateWebhook は新しいWebhookを登録します
+func (w *webhookInteractor) CreateWebhook(ctx context.Context, url string, eventTypes []domain.NoteEventType, secret string) (*domain.Webhook, error) {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return nil, err
+	}
+
+	webhook, err := domain.NewWebhook(url, eventTypes, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := w.webhookRepo.Create(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return created, nil
+}
+
+// GetWebhook はIDでWebhookを取得します
+func (w *webhookInteractor) GetWebhook(ctx context.Context, id int64) (*domain.Webhook, error) {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return nil, err
+	}
+
+	webhook, err := w.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook はWebhookを更新します
+func (w *webhookInteractor) UpdateWebhook(ctx context.Context, id int64, url string, eventTypes []domain.NoteEventType, secret string, active bool) (*domain.Webhook, error) {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return nil, err
+	}
+
+	webhook, err := w.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	if err := webhook.Update(url, eventTypes, secret, active); err != nil {
+		return nil, err
+	}
+
+	updated, err := w.webhookRepo.Update(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteWebhook はWebhookを削除します
+func (w *webhookInteractor) DeleteWebhook(ctx context.Context, id int64) error {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return err
+	}
+
+	if err := w.webhookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return nil
+}
+
+// ListWebhooks はWebhookの一覧を取得します
+func (w *webhookInteractor) ListWebhooks(ctx context.Context, limit int, afterID int64) ([]*domain.Webhook, int64, error) {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	limit = normalizePageSize(limit)
+
+	webhooks, err := w.webhookRepo.List(ctx, limit+1, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	var next int64
+	if len(webhooks) > limit {
+		webhooks = webhooks[:limit]
+		next = webhooks[limit-1].ID
+	}
+
+	return webhooks, next, nil
+}
+
+// ListWebhookDeliveries はWebhookの送信記録を取得します
+func (w *webhookInteractor) ListWebhookDeliveries(ctx context.Context, webhookID int64, limit int, beforeID int64) ([]*domain.WebhookDelivery, int64, error) {
+	if _, err := requirePrincipal(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	// 存在しないWebhookの場合は空の一覧ではなくNotFoundを返します
+	if _, err := w.webhookRepo.GetByID(ctx, webhookID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	limit = normalizePageSize(limit)
+
+	deliveries, err := w.deliveryRepo.List(ctx, webhookID, limit+1, beforeID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	var next int64
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		next = deliveries[limit-1].ID
+	}
+
+	return deliveries, next, nil
+}
+
+// webhookDispatchInteractor はWebhookDispatchUsecaseインターフェースを実装します
+type webhookDispatchInteractor struct {
+	webhookRepo  WebhookRepository
+	deliveryRepo WebhookDeliveryRepository
+	sender       WebhookSender
+	maxAttempts  int
+	now          func() time.Time
+}
+
+// NewWebhookDispatchInteractor は新しいWebhook送信インタラクターを作成します
+// maxAttempts回送信に失敗した送信待ちは再送を打ち切ります
+func NewWebhookDispatchInteractor(webhookRepo WebhookRepository, deliveryRepo WebhookDeliveryRepository, sender WebhookSender, maxAttempts int) WebhookDispatchUsecase {
+	return &webhookDispatchInteractor{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		maxAttempts:  maxAttempts,
+		now:          time.Now,
+	}
+}
+
+// EnqueueDeliveries はイベントを通知対象のWebhookごとの送信待ちとして記録します
+func (d *webhookDispatchInteractor) EnqueueDeliveries(ctx context.Context, event *domain.NoteEvent) (int, error) {
+	webhooks, err := d.webhookRepo.ListActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list active webhooks: %w", err)
+	}
+
+	var webhookIDs []int64
+	for _, webhook := range webhooks {
+		if webhook.Matches(event.Type) {
+			webhookIDs = append(webhookIDs, webhook.ID)
+		}
+	}
+	if len(webhookIDs) == 0 {
+		return 0, nil
+	}
+
+	n, err := d.deliveryRepo.Enqueue(ctx, webhookIDs, event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return n, nil
+}
+
+// DeliverDue は送信予定時刻を過ぎた送信待ちを古い順に送信します
+// 送信先ごとに障害の有無が異なるため、送信に失敗してもバッチを打ち切らずに残りを送信します
+func (d *webhookDispatchInteractor) DeliverDue(ctx context.Context) (int, int, error) {
+	succeeded, abandoned := 0, 0
+	for {
+		deliveries, err := d.deliveryRepo.ClaimDue(ctx, webhookDeliveryBatchSize, webhookDeliveryLease)
+		if err != nil {
+			return succeeded, abandoned, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+		}
+
+		// 同じバッチの送信待ちは同じWebhook宛てであることが多いため、取得したWebhookを使い回します
+		webhooks := make(map[int64]*domain.Webhook)
+		for _, delivery := range deliveries {
+			webhook, ok := webhooks[delivery.WebhookID]
+			if !ok {
+				webhook, err = d.webhookRepo.GetByID(ctx, delivery.WebhookID)
+				if errors.Is(err, domain.ErrWebhookNotFound) {
+					// 取得後にWebhookが削除された場合は送信記録も削除されています
+					continue
+				}
+				if err != nil {
+					return succeeded, abandoned, fmt.Errorf("failed to get webhook: %w", err)
+				}
+				webhooks[delivery.WebhookID] = webhook
+			}
+
+			status, err := d.deliver(ctx, webhook, delivery)
+			if err != nil {
+				return succeeded, abandoned, err
+			}
+			switch status {
+			case domain.WebhookDeliverySucceeded:
+				succeeded++
+			case domain.WebhookDeliveryFailed:
+				abandoned++
+			}
+		}
+
+		if len(deliveries) < webhookDeliveryBatchSize {
+			return succeeded, abandoned, nil
+		}
+	}
+}
+
+// deliver は1件の送信待ちを送信して結果を記録し、記録後の送信状況を返します
+// 結果を記録できなかった場合のみエラーを返します
+func (d *webhookDispatchInteractor) deliver(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (domain.WebhookDeliveryStatus, error) {
+	attempts := delivery.Attempts + 1
+
+	if !webhook.Active {
+		if err := d.deliveryRepo.MarkAbandoned(ctx, delivery.ID, 0, "webhook is disabled"); err != nil {
+			return "", fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+		return domain.WebhookDeliveryFailed, nil
+	}
+
+	body, err := delivery.Payload()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	timestamp := d.now()
+	header := map[string]string{
+		"Content-Type":        "application/json",
+		"X-Webhook-Id":        strconv.FormatInt(delivery.ID, 10),
+		"X-Webhook-Event":     domain.WebhookEventName(delivery.Event.Type),
+		"X-Webhook-Timestamp": strconv.FormatInt(timestamp.Unix(), 10),
+		"X-Webhook-Signature": domain.SignWebhookPayload(webhook.Secret, timestamp, body),
+	}
+
+	statusCode, err := d.sender.Send(ctx, webhook.URL, header, body)
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		if err := d.deliveryRepo.MarkSucceeded(ctx, delivery.ID, statusCode); err != nil {
+			return "", fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+		return domain.WebhookDeliverySucceeded, nil
+	}
+	if ctx.Err() != nil {
+		// 停止による失敗は送信先の問題ではないため記録せず、リース期限後に再送します
+		return "", ctx.Err()
+	}
+
+	cause := fmt.Sprintf("unexpected status code %d", statusCode)
+	if err != nil {
+		cause = err.Error()
+	}
+	if attempts >= d.maxAttempts {
+		if err := d.deliveryRepo.MarkAbandoned(ctx, delivery.ID, statusCode, cause); err != nil {
+			return "", fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+		return domain.WebhookDeliveryFailed, nil
+	}
+	if err := d.deliveryRepo.MarkFailed(ctx, delivery.ID, statusCode, cause, exponentialBackoff(attempts, webhookInitialBackoff, webhookMaxBackoff)); err != nil {
+		return "", fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return domain.WebhookDeliveryPending, nil
+}
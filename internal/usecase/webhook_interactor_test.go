@@ -0,0 +1,257 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go_test/internal/domain"
+	"go_test/internal/interface/webhook"
+	"go_test/internal/usecase"
+)
+
+// fakeWebhookRepository はテスト用のWebhookRepositoryです
+type fakeWebhookRepository struct {
+	webhooks map[int64]*domain.Webhook
+}
+
+func (r *fakeWebhookRepository) Create(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	w.ID = int64(len(r.webhooks) + 1)
+	r.webhooks[w.ID] = w
+	return w, nil
+}
+
+func (r *fakeWebhookRepository) GetByID(ctx context.Context, id int64) (*domain.Webhook, error) {
+	w, ok := r.webhooks[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return w, nil
+}
+
+func (r *fakeWebhookRepository) Update(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	r.webhooks[w.ID] = w
+	return w, nil
+}
+
+func (r *fakeWebhookRepository) Delete(ctx context.Context, id int64) error {
+	delete(r.webhooks, id)
+	return nil
+}
+
+func (r *fakeWebhookRepository) List(ctx context.Context, limit int, afterID int64) ([]*domain.Webhook, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepository) ListActive(ctx context.Context) ([]*domain.Webhook, error) {
+	var active []*domain.Webhook
+	for _, w := range r.webhooks {
+		if w.Active {
+			active = append(active, w)
+		}
+	}
+	return active, nil
+}
+
+// fakeDeliveryRepository はテスト用のWebhookDeliveryRepositoryです。送信結果の記録をdeliveriesに反映します
+type fakeDeliveryRepository struct {
+	mu         sync.Mutex
+	deliveries []*domain.WebhookDelivery
+	retryAfter []time.Duration
+}
+
+func (r *fakeDeliveryRepository) Enqueue(ctx context.Context, webhookIDs []int64, event *domain.NoteEvent) (int, error) {
+	for _, id := range webhookIDs {
+		r.deliveries = append(r.deliveries, &domain.WebhookDelivery{
+			ID:        int64(len(r.deliveries) + 1),
+			WebhookID: id,
+			Event:     *event,
+			Status:    domain.WebhookDeliveryPending,
+		})
+	}
+	return len(webhookIDs), nil
+}
+
+// ClaimDue は再送までの待機時間を無視して、送信待ちをすべて返します
+func (r *fakeDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	var due []*domain.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.Status == domain.WebhookDeliveryPending && len(due) < limit {
+			c := *d
+			due = append(due, &c)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeDeliveryRepository) record(id int64, status domain.WebhookDeliveryStatus, statusCode int, cause string) error {
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			d.Attempts++
+			d.Status = status
+			d.LastStatusCode = statusCode
+			d.LastError = cause
+			return nil
+		}
+	}
+	return errors.New("delivery not found")
+}
+
+func (r *fakeDeliveryRepository) MarkSucceeded(ctx context.Context, id int64, statusCode int) error {
+	return r.record(id, domain.WebhookDeliverySucceeded, statusCode, "")
+}
+
+func (r *fakeDeliveryRepository) MarkFailed(ctx context.Context, id int64, statusCode int, cause string, retryAfter time.Duration) error {
+	r.retryAfter = append(r.retryAfter, retryAfter)
+	return r.record(id, domain.WebhookDeliveryPending, statusCode, cause)
+}
+
+func (r *fakeDeliveryRepository) MarkAbandoned(ctx context.Context, id int64, statusCode int, cause string) error {
+	return r.record(id, domain.WebhookDeliveryFailed, statusCode, cause)
+}
+
+func (r *fakeDeliveryRepository) List(ctx context.Context, webhookID int64, limit int, beforeID int64) ([]*domain.WebhookDelivery, error) {
+	return r.deliveries, nil
+}
+
+const testWebhookSecret = "0123456789abcdef"
+
+// newDispatchFixture はurl宛てのWebhookを1つ登録し、イベントを1件送信待ちにします
+func newDispatchFixture(t *testing.T, url string, maxAttempts int) (usecase.WebhookDispatchUsecase, *fakeDeliveryRepository) {
+	t.Helper()
+	webhookRepo := &fakeWebhookRepository{webhooks: map[int64]*domain.Webhook{}}
+	deliveryRepo := &fakeDeliveryRepository{}
+	w, err := domain.NewWebhook(url, nil, testWebhookSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	webhookRepo.Create(context.Background(), w)
+
+	// httptestの受信側はループバックアドレスで待ち受けるため、内部ネットワークへの送信を許可します
+	dispatch := usecase.NewWebhookDispatchInteractor(webhookRepo, deliveryRepo, webhook.NewHTTPSender(5*time.Second, true), maxAttempts)
+	n, err := dispatch.EnqueueDeliveries(context.Background(), &domain.NoteEvent{ID: "1-0", Type: domain.NoteCreated, NoteID: 1, Version: 1, OccurredAt: time.Now()})
+	if err != nil || n != 1 {
+		t.Fatalf("EnqueueDeliveries() = %d, %v; want 1, nil", n, err)
+	}
+	return dispatch, deliveryRepo
+}
+
+func TestDeliverDueSignsAndRetries(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	var signatureErr error
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+
+		// 受信側と同じ手順で署名を検証します
+		body, _ := io.ReadAll(r.Body)
+		unix, err := strconv.ParseInt(r.Header.Get("X-Webhook-Timestamp"), 10, 64)
+		if err != nil {
+			signatureErr = err
+		} else if want := domain.SignWebhookPayload(testWebhookSecret, time.Unix(unix, 0), body); !hmac.Equal([]byte(r.Header.Get("X-Webhook-Signature")), []byte(want)) {
+			signatureErr = errors.New("signature mismatch")
+		}
+		if r.Header.Get("X-Webhook-Event") != "note.created" || r.Header.Get("X-Webhook-Id") != "1" {
+			signatureErr = errors.New("unexpected headers")
+		}
+
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	dispatch, deliveries := newDispatchFixture(t, receiver.URL, 3)
+	ctx := context.Background()
+
+	// 1回目は500で失敗し、再送待ちとして記録されます
+	succeeded, abandoned, err := dispatch.DeliverDue(ctx)
+	if err != nil || succeeded != 0 || abandoned != 0 {
+		t.Fatalf("first DeliverDue() = %d, %d, %v; want 0, 0, nil", succeeded, abandoned, err)
+	}
+	d := deliveries.deliveries[0]
+	if d.Status != domain.WebhookDeliveryPending || d.Attempts != 1 || d.LastStatusCode != http.StatusInternalServerError || d.LastError != "unexpected status code 500" {
+		t.Errorf("after failure delivery = %+v", d)
+	}
+	if len(deliveries.retryAfter) != 1 || deliveries.retryAfter[0] != 10*time.Second {
+		t.Errorf("retryAfter = %v, want [10s]", deliveries.retryAfter)
+	}
+
+	// 2回目は204で成功します
+	succeeded, abandoned, err = dispatch.DeliverDue(ctx)
+	if err != nil || succeeded != 1 || abandoned != 0 {
+		t.Fatalf("second DeliverDue() = %d, %d, %v; want 1, 0, nil", succeeded, abandoned, err)
+	}
+	if d.Status != domain.WebhookDeliverySucceeded || d.Attempts != 2 || d.LastStatusCode != http.StatusNoContent {
+		t.Errorf("after success delivery = %+v", d)
+	}
+	if signatureErr != nil {
+		t.Errorf("receiver: %v", signatureErr)
+	}
+}
+
+func TestDeliverDueAbandonsAfterMaxAttempts(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	dispatch, deliveries := newDispatchFixture(t, receiver.URL, 2)
+	ctx := context.Background()
+
+	if _, abandoned, err := dispatch.DeliverDue(ctx); err != nil || abandoned != 0 {
+		t.Fatalf("first DeliverDue() abandoned = %d, err = %v", abandoned, err)
+	}
+	if _, abandoned, err := dispatch.DeliverDue(ctx); err != nil || abandoned != 1 {
+		t.Fatalf("second DeliverDue() abandoned = %d, err = %v; want 1", abandoned, err)
+	}
+	d := deliveries.deliveries[0]
+	if d.Status != domain.WebhookDeliveryFailed || d.Attempts != 2 || d.LastStatusCode != http.StatusServiceUnavailable {
+		t.Errorf("delivery = %+v", d)
+	}
+	// 打ち切った送信待ちは再び送信されません
+	if succeeded, abandoned, err := dispatch.DeliverDue(ctx); err != nil || succeeded != 0 || abandoned != 0 {
+		t.Errorf("third DeliverDue() = %d, %d, %v; want nothing to deliver", succeeded, abandoned, err)
+	}
+}
+
+func TestDeliverDueRecordsConnectionErrors(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	// 接続できない送信先にします
+	receiver.Close()
+
+	dispatch, deliveries := newDispatchFixture(t, url, 3)
+	if _, _, err := dispatch.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	d := deliveries.deliveries[0]
+	if d.Status != domain.WebhookDeliveryPending || d.LastStatusCode != 0 || d.LastError == "" {
+		t.Errorf("delivery = %+v, want pending with the connection error recorded", d)
+	}
+}
+
+func TestWebhookManagementRequiresPrincipal(t *testing.T) {
+	webhooks := usecase.NewWebhookInteractor(&fakeWebhookRepository{webhooks: map[int64]*domain.Webhook{}}, &fakeDeliveryRepository{})
+
+	_, err := webhooks.CreateWebhook(context.Background(), "https://example.com/hook", nil, testWebhookSecret)
+	if !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Fatalf("CreateWebhook() without principal error = %v, want %v", err, domain.ErrUnauthenticated)
+	}
+
+	ctx := usecase.WithPrincipal(context.Background(), &domain.Principal{ID: "alice"})
+	if _, err := webhooks.CreateWebhook(ctx, "https://example.com/hook", nil, testWebhookSecret); err != nil {
+		t.Fatalf("CreateWebhook() with principal error = %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+-- Track modification time and an optimistic concurrency version on notes
+USE go_test;
+
+ALTER TABLE notes
+  ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP AFTER created_at,
+  ADD COLUMN version BIGINT NOT NULL DEFAULT 1 AFTER updated_at;
+
+UPDATE notes SET updated_at = created_at WHERE created_at IS NOT NULL;
@@ -0,0 +1,17 @@
+-- Keep the full history of note contents, one row per version
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS note_revisions (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  note_id BIGINT NOT NULL,
+  version BIGINT NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  content TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uk_note_version (note_id, version),
+  CONSTRAINT fk_note_revisions_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- Record the current content of existing notes as their latest revision
+INSERT IGNORE INTO note_revisions (note_id, version, title, content, created_at)
+SELECT id, version, title, content, updated_at FROM notes;
@@ -0,0 +1,6 @@
+-- Move deleted notes to the trash instead of removing them immediately
+USE go_test;
+
+ALTER TABLE notes
+  ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL AFTER version,
+  ADD INDEX idx_deleted_at (deleted_at);
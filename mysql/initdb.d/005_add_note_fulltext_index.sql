@@ -0,0 +1,6 @@
+-- Full-text search over title and content. The ngram parser tokenizes text
+-- without word separators such as Japanese (ngram_token_size defaults to 2).
+USE go_test;
+
+ALTER TABLE notes
+  ADD FULLTEXT INDEX ft_notes_title_content (title, content) WITH PARSER ngram;
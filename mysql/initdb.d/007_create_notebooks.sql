@@ -0,0 +1,19 @@
+-- Nestable notebooks (folders) that notes can be filed into. A notebook can
+-- only be deleted while it has no child notebooks and no notes outside the
+-- trash; trashed notes left in it become unfiled.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS notebooks (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  parent_id BIGINT NULL DEFAULT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_notebooks_parent (parent_id),
+  CONSTRAINT fk_notebooks_parent FOREIGN KEY (parent_id) REFERENCES notebooks (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE notes
+  ADD COLUMN notebook_id BIGINT NULL DEFAULT NULL AFTER deleted_at,
+  ADD INDEX idx_notes_notebook (notebook_id),
+  ADD CONSTRAINT fk_notes_notebook FOREIGN KEY (notebook_id) REFERENCES notebooks (id) ON DELETE SET NULL;
@@ -0,0 +1,8 @@
+-- Pinned notes are listed first; archived notes are hidden from listings
+-- unless explicitly requested.
+USE go_test;
+
+ALTER TABLE notes
+  ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE AFTER notebook_id,
+  ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE AFTER pinned,
+  ADD INDEX idx_notes_listing (archived, pinned, id);
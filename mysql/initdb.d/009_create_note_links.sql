@@ -0,0 +1,15 @@
+-- Wiki-style [[note-id]] / [[title]] links parsed from note content. Links by
+-- title are stored unresolved and matched against note titles when read, so
+-- renamed or later-created notes are picked up without rewriting links.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS note_links (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  source_note_id BIGINT NOT NULL,
+  target_note_id BIGINT NULL DEFAULT NULL,
+  target_title VARCHAR(255) NULL DEFAULT NULL,
+  INDEX idx_note_links_source (source_note_id),
+  INDEX idx_note_links_target_note (target_note_id),
+  INDEX idx_note_links_target_title (target_title),
+  CONSTRAINT fk_note_links_source FOREIGN KEY (source_note_id) REFERENCES notes (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
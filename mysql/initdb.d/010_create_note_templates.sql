@@ -0,0 +1,12 @@
+-- Templates for creating notes with a fixed structure (meeting notes,
+-- incident reports). Title and content are text/template sources.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS note_templates (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  title_template VARCHAR(1024) NOT NULL,
+  content_template TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
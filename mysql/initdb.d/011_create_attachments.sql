@@ -0,0 +1,17 @@
+-- Metadata of files attached to notes. The content lives in the blob store,
+-- keyed by its SHA-256 digest, so identical files share one blob. Blobs no
+-- longer referenced by any row are removed by the attachment sweeper.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS attachments (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  note_id BIGINT NOT NULL,
+  filename VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size BIGINT NOT NULL,
+  sha256 CHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_attachments_note (note_id),
+  INDEX idx_attachments_sha256 (sha256),
+  CONSTRAINT fk_attachments_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,17 @@
+-- Comments on notes. Replies point at their parent comment in the same note.
+-- Deleted comments keep their row (with an empty body) so replies stay
+-- attached to the thread; all comments are removed when the note is purged.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS comments (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  note_id BIGINT NOT NULL,
+  parent_id BIGINT NULL,
+  author VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP NULL,
+  INDEX idx_comments_thread (note_id, parent_id, id),
+  CONSTRAINT fk_comments_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
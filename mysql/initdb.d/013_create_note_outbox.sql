@@ -0,0 +1,18 @@
+-- Transactional outbox for note change events. Rows are written in the same
+-- transaction as the note change and deleted once the relay has published
+-- them to the Redis stream. Rows that keep failing are kept with
+-- dead_lettered_at set; clear it (and attempts) to publish them again.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS note_outbox (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  event_type VARCHAR(32) NOT NULL,
+  note_id BIGINT NOT NULL,
+  version BIGINT NOT NULL,
+  occurred_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  last_error TEXT NULL,
+  dead_lettered_at TIMESTAMP(6) NULL,
+  INDEX idx_note_outbox_pending (dead_lettered_at, next_attempt_at, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
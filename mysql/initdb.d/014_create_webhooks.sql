@@ -0,0 +1,38 @@
+-- Outgoing webhooks for note events. Subscriptions store the target URL, an
+-- optional comma-separated event type filter (empty = all events) and the
+-- HMAC secret. Each event is recorded once per matching subscription in
+-- webhook_deliveries, which doubles as the retry queue and the delivery log.
+USE go_test;
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  url VARCHAR(2048) NOT NULL,
+  event_types VARCHAR(255) NOT NULL DEFAULT '',
+  secret VARCHAR(255) NOT NULL,
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_webhook_subscriptions_active (active, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  subscription_id BIGINT NOT NULL,
+  event_id VARCHAR(64) NOT NULL,
+  event_type VARCHAR(32) NOT NULL,
+  note_id BIGINT NOT NULL,
+  version BIGINT NOT NULL,
+  occurred_at TIMESTAMP(6) NOT NULL,
+  status VARCHAR(16) NOT NULL DEFAULT 'pending',
+  attempts INT NOT NULL DEFAULT 0,
+  last_status_code INT NOT NULL DEFAULT 0,
+  last_error TEXT NULL,
+  next_attempt_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  last_attempt_at TIMESTAMP(6) NULL,
+  delivered_at TIMESTAMP(6) NULL,
+  created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  UNIQUE KEY uk_webhook_deliveries_event (subscription_id, event_id),
+  INDEX idx_webhook_deliveries_due (status, next_attempt_at, id),
+  INDEX idx_webhook_deliveries_subscription (subscription_id, id),
+  CONSTRAINT fk_webhook_deliveries_subscription FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,7 @@
+-- Hash of title and content, used to skip notes that already exist when
+-- importing. Matches domain.ContentHash.
+USE go_test;
+
+ALTER TABLE notes
+  ADD COLUMN content_hash CHAR(64) AS (SHA2(CONCAT(title, '\n', content), 256)) STORED,
+  ADD INDEX idx_notes_content_hash (content_hash);
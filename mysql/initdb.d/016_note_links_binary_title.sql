@@ -0,0 +1,7 @@
+-- Title links are matched against note titles exactly (case, accents and
+-- width are significant), so the stored title uses a binary collation and
+-- its index stays usable for those comparisons.
+USE go_test;
+
+ALTER TABLE note_links
+  MODIFY COLUMN target_title VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL DEFAULT NULL;
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Config はGoTestServiceへの接続設定を保持します
+type Config struct {
+	// Address は接続先のアドレス（"host:port"）です
+	Address string
+	// Token はリクエストごとに"authorization: Bearer <token>"メタデータとして送る認証トークンです。空の場合は送りません
+	Token string
+	// TLS はTLSの設定です。nilの場合はシステムのルート証明書で検証します
+	TLS *tls.Config
+	// Insecure がtrueの場合はTLSを使わずに接続します（ローカル開発用）
+	Insecure bool
+	// Timeout は期限のないコンテキストで呼び出した単項RPCに設定する期限です（再試行を含む）。0の場合は設定しません
+	Timeout time.Duration
+	// MaxAttempts はUnavailableで失敗した単項RPCを試行する最大回数です（最初の試行を含む）。1以下の場合は再試行しません
+	// 既定で再試行するのは読み取りのRPCと冪等キーを指定したリクエストのみで、それ以外はWithRetryを指定した場合に限ります
+	MaxAttempts int
+	// InitialBackoff は最初の再試行までの待ち時間です。再試行のたびに倍になります
+	InitialBackoff time.Duration
+	// MaxBackoff は再試行までの待ち時間の上限です
+	MaxBackoff time.Duration
+	// DialOptions は追加のダイアルオプションです
+	DialOptions []grpc.DialOption
+}
+
+// NewConfig は既定値の接続設定を作成します
+func NewConfig(address string) *Config {
+	return &Config{
+		Address:        address,
+		Timeout:        10 * time.Second,
+		MaxAttempts:    4,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+	}
+}
+
+// Client はGoTestServiceのクライアントです
+// 生成されたGoTestServiceClientのすべてのRPCに、認証トークンの付与、期限の設定、Unavailableの再試行を適用します
+// ストリーミングRPCは再送できないため再試行せず、終わりのないストリームがあるため期限も設定しません
+type Client struct {
+	v1.GoTestServiceClient
+	conn *grpc.ClientConn
+}
+
+// Dial は接続設定に従ってGoTestServiceのクライアントを作成します
+// 接続は最初のRPCの呼び出し時に確立されます
+func Dial(config *Config) (*Client, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	transport := insecure.NewCredentials()
+	if !config.Insecure {
+		tlsConfig := config.TLS
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		transport = credentials.NewTLS(tlsConfig)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transport),
+		grpc.WithChainUnaryInterceptor(
+			timeoutUnaryInterceptor(config.Timeout),
+			retryUnaryInterceptor(config.MaxAttempts, config.InitialBackoff, config.MaxBackoff),
+		),
+	}
+	if config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: config.Token, requireTLS: !config.Insecure}))
+	}
+	opts = append(opts, config.DialOptions...)
+
+	conn, err := grpc.NewClient(config.Address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for %s: %w", config.Address, err)
+	}
+	return &Client{GoTestServiceClient: v1.NewGoTestServiceClient(conn), conn: conn}, nil
+}
+
+// Close は接続を閉じます
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
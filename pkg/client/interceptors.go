@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// readOnlyMethods は何度呼び出しても結果が変わらない（サーバーの状態を変更しない）RPCです
+var readOnlyMethods = map[string]bool{
+	"Ping":                  true,
+	"GetNote":               true,
+	"BatchGetNotes":         true,
+	"ListNoteRevisions":     true,
+	"GetNoteRevision":       true,
+	"DiffNote":              true,
+	"ListTrash":             true,
+	"SearchNotes":           true,
+	"ListNotes":             true,
+	"ListTags":              true,
+	"GetNotebook":           true,
+	"ListNotebooks":         true,
+	"RenderNote":            true,
+	"GetBacklinks":          true,
+	"GetOutgoingLinks":      true,
+	"GetNoteTemplate":       true,
+	"ListNoteTemplates":     true,
+	"ListAttachments":       true,
+	"ListComments":          true,
+	"GetWebhook":            true,
+	"ListWebhooks":          true,
+	"ListWebhookDeliveries": true,
+}
+
+// tokenCredentials はリクエストごとに認証トークンを"authorization"メタデータとして送るcredentials.PerRPCCredentialsです
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+// timeoutCallOption は1回の呼び出しの期限を指定するgrpc.CallOptionです
+type timeoutCallOption struct {
+	grpc.EmptyCallOption
+	timeout time.Duration
+}
+
+// WithTimeout は単項RPCの1回の呼び出し（再試行を含む）の期限を指定します
+// Config.Timeoutより優先されますが、コンテキストの期限の方が早い場合はコンテキストの期限になります
+func WithTimeout(timeout time.Duration) grpc.CallOption {
+	return timeoutCallOption{timeout: timeout}
+}
+
+// retryCallOption は再試行するかどうかを明示するgrpc.CallOptionです
+type retryCallOption struct {
+	grpc.EmptyCallOption
+	retry bool
+}
+
+// WithRetry は読み取り以外のRPCもUnavailableで失敗した場合に再試行することを指定します
+// Unavailableで失敗した呼び出しもサーバーで処理済みの場合があるため、二重に処理されても問題ないRPCにのみ指定してください
+func WithRetry() grpc.CallOption {
+	return retryCallOption{retry: true}
+}
+
+// WithoutRetry は読み取りのRPCや冪等キーを指定したRPCでも再試行しないことを指定します
+func WithoutRetry() grpc.CallOption {
+	return retryCallOption{retry: false}
+}
+
+// timeoutUnaryInterceptor は呼び出しごとの期限を設定します
+func timeoutUnaryInterceptor(defaultTimeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		timeout := defaultTimeout
+		explicit := false
+		for _, opt := range opts {
+			if o, ok := opt.(timeoutCallOption); ok {
+				timeout = o.timeout
+				explicit = true
+			}
+		}
+		// 既定の期限はコンテキストに期限がない場合だけ設定します
+		if _, ok := ctx.Deadline(); timeout > 0 && (explicit || !ok) {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// retryUnaryInterceptor はUnavailableで失敗した呼び出しを指数バックオフ（ジッター付き）で再試行します
+// Unavailableはサーバーが処理を終えた後に接続が切れた場合にも返るため、既定では再試行しても結果が変わらない
+// 読み取りのRPCと冪等キーを指定したリクエストのみを再試行し、それ以外はWithRetryを指定した場合に限ります
+func retryUnaryInterceptor(maxAttempts int, initialBackoff, maxBackoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		retry := readOnlyMethods[path.Base(method)] || hasIdempotencyKey(ctx, req)
+		for _, opt := range opts {
+			if o, ok := opt.(retryCallOption); ok {
+				retry = o.retry
+			}
+		}
+		attempts := maxAttempts
+		if !retry {
+			attempts = 1
+		}
+
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= attempts || status.Code(err) != codes.Unavailable {
+				return err
+			}
+
+			timer := time.NewTimer(backoff(attempt, initialBackoff, maxBackoff))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				// 最後の失敗の理由の方がコンテキストのエラーより役に立つため、そちらを返します
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// hasIdempotencyKey はリクエストのidempotency_keyフィールドまたは"idempotency-key"メタデータに冪等キーが指定されているかを判定します
+// 冪等キーを指定したリクエストはサーバーが重複を検出するため、再試行しても二重に処理されません
+func hasIdempotencyKey(ctx context.Context, req interface{}) bool {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if values := md.Get("idempotency-key"); len(values) > 0 && values[0] != "" {
+			return true
+		}
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("idempotency_key")
+	return fd != nil && m.Get(fd).String() != ""
+}
+
+// backoff はattempt回目の失敗の後の待ち時間を返します
+// initialBackoffから倍々に増やしてmaxBackoffを上限とし、同時に失敗したクライアントが揃って再試行しないよう半分から全体の間でばらつかせます
+func backoff(attempt int, initialBackoff, maxBackoff time.Duration) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
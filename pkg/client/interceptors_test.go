@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	const service = "/go_test.v1.GoTestService/"
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		req          interface{}
+		opts         []grpc.CallOption
+		code         codes.Code
+		wantAttempts int
+	}{
+		{
+			name:         "read is retried",
+			method:       "GetNote",
+			req:          &v1.GetNoteRequest{Id: 1},
+			code:         codes.Unavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "write is not retried by default",
+			method:       "UpdateNote",
+			req:          &v1.UpdateNoteRequest{Id: 1},
+			code:         codes.Unavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "create without an idempotency key is not retried",
+			method:       "CreateNote",
+			req:          &v1.CreateNoteRequest{Title: "title"},
+			code:         codes.Unavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "create with an idempotency key field is retried",
+			method:       "CreateNote",
+			req:          &v1.CreateNoteRequest{Title: "title", IdempotencyKey: "key-1"},
+			code:         codes.Unavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "create with idempotency key metadata is retried",
+			ctx:          metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", "key-1"),
+			method:       "CreateNote",
+			req:          &v1.CreateNoteRequest{Title: "title"},
+			code:         codes.Unavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "write opted in",
+			method:       "DeleteNote",
+			req:          &v1.DeleteNoteRequest{Id: 1},
+			opts:         []grpc.CallOption{WithRetry()},
+			code:         codes.Unavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "read opted out",
+			method:       "ListNotes",
+			req:          &v1.ListNotesRequest{},
+			opts:         []grpc.CallOption{WithoutRetry()},
+			code:         codes.Unavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "other codes are not retried",
+			method:       "GetNote",
+			req:          &v1.GetNoteRequest{Id: 1},
+			code:         codes.NotFound,
+			wantAttempts: 1,
+		},
+	}
+
+	interceptor := retryUnaryInterceptor(3, time.Millisecond, time.Millisecond)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			attempts := 0
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				attempts++
+				return status.Error(tt.code, "failed")
+			}
+			err := interceptor(ctx, service+tt.method, tt.req, nil, nil, invoker, tt.opts...)
+			if status.Code(err) != tt.code {
+				t.Errorf("error = %v, want %v", err, tt.code)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestReadOnlyMethodsExist(t *testing.T) {
+	methods := v1.File_proto_go_test_v1_go_test_proto.Services().Get(0).Methods()
+	for name := range readOnlyMethods {
+		md := methods.ByName(protoreflect.Name(name))
+		if md == nil {
+			t.Errorf("read-only method %s is not defined by the service", name)
+			continue
+		}
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			t.Errorf("read-only method %s is a streaming RPC", name)
+		}
+	}
+}
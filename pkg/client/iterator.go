@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+)
+
+// Iterator はページングされた一覧APIの結果を、必要に応じて次のページを取得しながら1件ずつ返します
+//
+//	it := c.IterNotes(ctx, &v1.ListNotesRequest{Tags: []string{"work"}})
+//	for it.Next() {
+//		fmt.Println(it.Value().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, pageToken string) ([]T, string, error)
+	token string
+	items []T
+	cur   T
+	done  bool
+	err   error
+}
+
+// newIterator はpageTokenのページから始まるイテレーターを作成します
+func newIterator[T any](ctx context.Context, pageToken string, fetch func(ctx context.Context, pageToken string) ([]T, string, error)) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch, token: pageToken}
+}
+
+// Next は次の要素に進みます。要素がなくなったかエラーが起きた場合はfalseを返します
+func (it *Iterator[T]) Next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		items, next, err := it.fetch(it.ctx, it.token)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+		it.token = next
+		it.done = next == ""
+	}
+	it.cur = it.items[0]
+	it.items = it.items[1:]
+	return true
+}
+
+// Value は現在の要素を返します
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err はページの取得で起きたエラーを返します
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
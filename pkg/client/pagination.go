@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+
+	v1 "go_test/proto/go_test/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// IterNoteRevisions はListNoteRevisionsの結果のノートのリビジョンをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterNoteRevisions(ctx context.Context, req *v1.ListNoteRevisionsRequest, opts ...grpc.CallOption) *Iterator[*v1.NoteRevision] {
+	req = proto.Clone(req).(*v1.ListNoteRevisionsRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.NoteRevision, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListNoteRevisions(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Revisions, resp.NextPageToken, nil
+	})
+}
+
+// IterTrash はListTrashの結果のゴミ箱にあるノートをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterTrash(ctx context.Context, req *v1.ListTrashRequest, opts ...grpc.CallOption) *Iterator[*v1.Note] {
+	req = proto.Clone(req).(*v1.ListTrashRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Note, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListTrash(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Notes, resp.NextPageToken, nil
+	})
+}
+
+// IterSearchResults はSearchNotesの結果のノートの検索結果をすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterSearchResults(ctx context.Context, req *v1.SearchNotesRequest, opts ...grpc.CallOption) *Iterator[*v1.SearchResult] {
+	req = proto.Clone(req).(*v1.SearchNotesRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.SearchResult, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.SearchNotes(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Results, resp.NextPageToken, nil
+	})
+}
+
+// IterNotes はListNotesの結果のノートをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterNotes(ctx context.Context, req *v1.ListNotesRequest, opts ...grpc.CallOption) *Iterator[*v1.Note] {
+	req = proto.Clone(req).(*v1.ListNotesRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Note, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListNotes(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Notes, resp.NextPageToken, nil
+	})
+}
+
+// IterNotebooks はListNotebooksの結果のノートブックをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterNotebooks(ctx context.Context, req *v1.ListNotebooksRequest, opts ...grpc.CallOption) *Iterator[*v1.Notebook] {
+	req = proto.Clone(req).(*v1.ListNotebooksRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Notebook, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListNotebooks(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Notebooks, resp.NextPageToken, nil
+	})
+}
+
+// IterBacklinks はGetBacklinksの結果のノートへのリンク元のノートをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterBacklinks(ctx context.Context, req *v1.GetBacklinksRequest, opts ...grpc.CallOption) *Iterator[*v1.Note] {
+	req = proto.Clone(req).(*v1.GetBacklinksRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Note, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.GetBacklinks(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Notes, resp.NextPageToken, nil
+	})
+}
+
+// IterNoteTemplates はListNoteTemplatesの結果のノートのテンプレートをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterNoteTemplates(ctx context.Context, req *v1.ListNoteTemplatesRequest, opts ...grpc.CallOption) *Iterator[*v1.NoteTemplate] {
+	req = proto.Clone(req).(*v1.ListNoteTemplatesRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.NoteTemplate, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListNoteTemplates(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Templates, resp.NextPageToken, nil
+	})
+}
+
+// IterComments はListCommentsの結果のコメントをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterComments(ctx context.Context, req *v1.ListCommentsRequest, opts ...grpc.CallOption) *Iterator[*v1.Comment] {
+	req = proto.Clone(req).(*v1.ListCommentsRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Comment, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListComments(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Comments, resp.NextPageToken, nil
+	})
+}
+
+// IterWebhooks はListWebhooksの結果のWebhookをすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterWebhooks(ctx context.Context, req *v1.ListWebhooksRequest, opts ...grpc.CallOption) *Iterator[*v1.Webhook] {
+	req = proto.Clone(req).(*v1.ListWebhooksRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.Webhook, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListWebhooks(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Webhooks, resp.NextPageToken, nil
+	})
+}
+
+// IterWebhookDeliveries はListWebhookDeliveriesの結果のWebhookの送信記録をすべてのページにわたって返すイテレーターを作成します
+// reqのpage_tokenのページから始めます。page_sizeは1ページあたりの件数です
+func (c *Client) IterWebhookDeliveries(ctx context.Context, req *v1.ListWebhookDeliveriesRequest, opts ...grpc.CallOption) *Iterator[*v1.WebhookDelivery] {
+	req = proto.Clone(req).(*v1.ListWebhookDeliveriesRequest)
+	return newIterator(ctx, req.PageToken, func(ctx context.Context, pageToken string) ([]*v1.WebhookDelivery, string, error) {
+		req.PageToken = pageToken
+		resp, err := c.ListWebhookDeliveries(ctx, req, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Deliveries, resp.NextPageToken, nil
+	})
+}
@@ -22,6 +22,402 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BulkCreateMode int32
+
+const (
+	// Same as BULK_CREATE_MODE_PARTIAL.
+	BulkCreateMode_BULK_CREATE_MODE_UNSPECIFIED BulkCreateMode = 0
+	// Valid notes are created even if others fail. Notes are validated and
+	// written as they arrive, in batches of 500 per transaction; a database
+	// error fails only its batch. Batches already written stay created if the
+	// stream fails later.
+	BulkCreateMode_BULK_CREATE_MODE_PARTIAL BulkCreateMode = 1
+	// Notes are created in a single transaction only if every note is valid.
+	// Otherwise nothing is created and the valid notes report ABORTED. The
+	// notes are held until the stream ends, so their titles, content and tags
+	// may total at most 32 MiB.
+	BulkCreateMode_BULK_CREATE_MODE_ALL_OR_NOTHING BulkCreateMode = 2
+)
+
+// Enum value maps for BulkCreateMode.
+var (
+	BulkCreateMode_name = map[int32]string{
+		0: "BULK_CREATE_MODE_UNSPECIFIED",
+		1: "BULK_CREATE_MODE_PARTIAL",
+		2: "BULK_CREATE_MODE_ALL_OR_NOTHING",
+	}
+	BulkCreateMode_value = map[string]int32{
+		"BULK_CREATE_MODE_UNSPECIFIED":    0,
+		"BULK_CREATE_MODE_PARTIAL":        1,
+		"BULK_CREATE_MODE_ALL_OR_NOTHING": 2,
+	}
+)
+
+func (x BulkCreateMode) Enum() *BulkCreateMode {
+	p := new(BulkCreateMode)
+	*p = x
+	return p
+}
+
+func (x BulkCreateMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkCreateMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[0].Descriptor()
+}
+
+func (BulkCreateMode) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[0]
+}
+
+func (x BulkCreateMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkCreateMode.Descriptor instead.
+func (BulkCreateMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{0}
+}
+
+// Diff messages
+type DiffGranularity int32
+
+const (
+	// Defaults to LINE.
+	DiffGranularity_DIFF_GRANULARITY_UNSPECIFIED DiffGranularity = 0
+	DiffGranularity_DIFF_GRANULARITY_LINE        DiffGranularity = 1
+	// Words, whitespace runs and punctuation. Han, Hiragana and Katakana are
+	// compared character by character.
+	DiffGranularity_DIFF_GRANULARITY_WORD DiffGranularity = 2
+)
+
+// Enum value maps for DiffGranularity.
+var (
+	DiffGranularity_name = map[int32]string{
+		0: "DIFF_GRANULARITY_UNSPECIFIED",
+		1: "DIFF_GRANULARITY_LINE",
+		2: "DIFF_GRANULARITY_WORD",
+	}
+	DiffGranularity_value = map[string]int32{
+		"DIFF_GRANULARITY_UNSPECIFIED": 0,
+		"DIFF_GRANULARITY_LINE":        1,
+		"DIFF_GRANULARITY_WORD":        2,
+	}
+)
+
+func (x DiffGranularity) Enum() *DiffGranularity {
+	p := new(DiffGranularity)
+	*p = x
+	return p
+}
+
+func (x DiffGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[1].Descriptor()
+}
+
+func (DiffGranularity) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[1]
+}
+
+func (x DiffGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffGranularity.Descriptor instead.
+func (DiffGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{1}
+}
+
+// Watch messages
+type NoteEventType int32
+
+const (
+	NoteEventType_NOTE_EVENT_TYPE_UNSPECIFIED NoteEventType = 0
+	NoteEventType_NOTE_EVENT_TYPE_CREATED     NoteEventType = 1
+	// Content, tags, pinned/archived state or notebook changed.
+	NoteEventType_NOTE_EVENT_TYPE_UPDATED NoteEventType = 2
+	// Moved to the trash.
+	NoteEventType_NOTE_EVENT_TYPE_DELETED NoteEventType = 3
+	// Restored from the trash.
+	NoteEventType_NOTE_EVENT_TYPE_RESTORED NoteEventType = 4
+	// Permanently deleted from the trash, either explicitly or after
+	// TRASH_RETENTION. The version is the last version before deletion.
+	NoteEventType_NOTE_EVENT_TYPE_PURGED NoteEventType = 5
+)
+
+// Enum value maps for NoteEventType.
+var (
+	NoteEventType_name = map[int32]string{
+		0: "NOTE_EVENT_TYPE_UNSPECIFIED",
+		1: "NOTE_EVENT_TYPE_CREATED",
+		2: "NOTE_EVENT_TYPE_UPDATED",
+		3: "NOTE_EVENT_TYPE_DELETED",
+		4: "NOTE_EVENT_TYPE_RESTORED",
+		5: "NOTE_EVENT_TYPE_PURGED",
+	}
+	NoteEventType_value = map[string]int32{
+		"NOTE_EVENT_TYPE_UNSPECIFIED": 0,
+		"NOTE_EVENT_TYPE_CREATED":     1,
+		"NOTE_EVENT_TYPE_UPDATED":     2,
+		"NOTE_EVENT_TYPE_DELETED":     3,
+		"NOTE_EVENT_TYPE_RESTORED":    4,
+		"NOTE_EVENT_TYPE_PURGED":      5,
+	}
+)
+
+func (x NoteEventType) Enum() *NoteEventType {
+	p := new(NoteEventType)
+	*p = x
+	return p
+}
+
+func (x NoteEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[2].Descriptor()
+}
+
+func (NoteEventType) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[2]
+}
+
+func (x NoteEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteEventType.Descriptor instead.
+func (NoteEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{2}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	// Waiting for the first attempt or a retry.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED WebhookDeliveryStatus = 2
+	// Given up after WEBHOOK_MAX_ATTEMPTS or because the webhook was disabled.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[3].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[3]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{3}
+}
+
+// Export messages
+type ExportFormat int32
+
+const (
+	// Same as EXPORT_FORMAT_MARKDOWN_ZIP.
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// A zip archive of "<id>-<title>.md" files, each starting with YAML front
+	// matter (id, title, created, updated, tags, notebook_id, pinned, archived,
+	// version) followed by the note content.
+	ExportFormat_EXPORT_FORMAT_MARKDOWN_ZIP ExportFormat = 1
+	// The same Markdown files in a gzip-compressed tar archive.
+	ExportFormat_EXPORT_FORMAT_MARKDOWN_TAR ExportFormat = 2
+	// One JSON object per line.
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 3
+	// CSV with a header row. Tags are newline-separated within their cell.
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_MARKDOWN_ZIP",
+		2: "EXPORT_FORMAT_MARKDOWN_TAR",
+		3: "EXPORT_FORMAT_NDJSON",
+		4: "EXPORT_FORMAT_CSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED":  0,
+		"EXPORT_FORMAT_MARKDOWN_ZIP": 1,
+		"EXPORT_FORMAT_MARKDOWN_TAR": 2,
+		"EXPORT_FORMAT_NDJSON":       3,
+		"EXPORT_FORMAT_CSV":          4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[4]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportNoteStatus int32
+
+const (
+	ImportNoteStatus_IMPORT_NOTE_STATUS_UNSPECIFIED ImportNoteStatus = 0
+	ImportNoteStatus_IMPORT_NOTE_STATUS_CREATED     ImportNoteStatus = 1
+	ImportNoteStatus_IMPORT_NOTE_STATUS_DUPLICATE   ImportNoteStatus = 2
+	ImportNoteStatus_IMPORT_NOTE_STATUS_FAILED      ImportNoteStatus = 3
+)
+
+// Enum value maps for ImportNoteStatus.
+var (
+	ImportNoteStatus_name = map[int32]string{
+		0: "IMPORT_NOTE_STATUS_UNSPECIFIED",
+		1: "IMPORT_NOTE_STATUS_CREATED",
+		2: "IMPORT_NOTE_STATUS_DUPLICATE",
+		3: "IMPORT_NOTE_STATUS_FAILED",
+	}
+	ImportNoteStatus_value = map[string]int32{
+		"IMPORT_NOTE_STATUS_UNSPECIFIED": 0,
+		"IMPORT_NOTE_STATUS_CREATED":     1,
+		"IMPORT_NOTE_STATUS_DUPLICATE":   2,
+		"IMPORT_NOTE_STATUS_FAILED":      3,
+	}
+)
+
+func (x ImportNoteStatus) Enum() *ImportNoteStatus {
+	p := new(ImportNoteStatus)
+	*p = x
+	return p
+}
+
+func (x ImportNoteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportNoteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportNoteStatus) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[5]
+}
+
+func (x ImportNoteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportNoteStatus.Descriptor instead.
+func (ImportNoteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{5}
+}
+
+type DiffEdit_Op int32
+
+const (
+	DiffEdit_OP_UNSPECIFIED DiffEdit_Op = 0
+	DiffEdit_OP_EQUAL       DiffEdit_Op = 1
+	DiffEdit_OP_DELETE      DiffEdit_Op = 2
+	DiffEdit_OP_INSERT      DiffEdit_Op = 3
+)
+
+// Enum value maps for DiffEdit_Op.
+var (
+	DiffEdit_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_EQUAL",
+		2: "OP_DELETE",
+		3: "OP_INSERT",
+	}
+	DiffEdit_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_EQUAL":       1,
+		"OP_DELETE":      2,
+		"OP_INSERT":      3,
+	}
+)
+
+func (x DiffEdit_Op) Enum() *DiffEdit_Op {
+	p := new(DiffEdit_Op)
+	*p = x
+	return p
+}
+
+func (x DiffEdit_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffEdit_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_go_test_v1_go_test_proto_enumTypes[6].Descriptor()
+}
+
+func (DiffEdit_Op) Type() protoreflect.EnumType {
+	return &file_proto_go_test_v1_go_test_proto_enumTypes[6]
+}
+
+func (x DiffEdit_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffEdit_Op.Descriptor instead.
+func (DiffEdit_Op) EnumDescriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{26, 0}
+}
+
 // Ping messages
 type PingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -40,10 +436,7469 @@ func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{0}
+}
+
+type PingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MysqlAvailable bool                   `protobuf:"varint,1,opt,name=mysql_available,json=mysqlAvailable,proto3" json:"mysql_available,omitempty"`
+	RedisAvailable bool                   `protobuf:"varint,2,opt,name=redis_available,json=redisAvailable,proto3" json:"redis_available,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PingResponse) GetMysqlAvailable() bool {
+	if x != nil {
+		return x.MysqlAvailable
+	}
+	return false
+}
+
+func (x *PingResponse) GetRedisAvailable() bool {
+	if x != nil {
+		return x.RedisAvailable
+	}
+	return false
+}
+
+func (x *PingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Note messages
+type Note struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version   int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Etag      string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Set while the note is in the trash.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Tags      []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Notebook the note is filed in. 0 when the note is not in any notebook.
+	NotebookId int64 `protobuf:"varint,10,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Pinned notes are listed before other notes.
+	Pinned bool `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Archived notes are hidden from ListNotes unless include_archived is set.
+	Archived      bool `protobuf:"varint,12,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Note) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Note) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Note) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Note) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Note) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Note) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Note) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Note) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Note) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Note) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *Note) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Note) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// Tags are normalized on write: NFKC (full-width alphanumerics become
+// half-width, half-width katakana become full-width), lower-cased and with
+// whitespace runs collapsed. Duplicates after normalization are dropped.
+type TagList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagList) Reset() {
+	*x = TagList{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagList) ProtoMessage() {}
+
+func (x *TagList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagList.ProtoReflect.Descriptor instead.
+func (*TagList) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TagList) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateNoteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. Retries carrying the same key return the originally created note.
+	// The "idempotency-key" request metadata is used when this field is empty.
+	IdempotencyKey string   `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Tags           []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional notebook to file the note in.
+	NotebookId    int64 `protobuf:"varint,5,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+type CreateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId    int64                  `protobuf:"varint,9,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Archived      bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteResponse) Reset() {
+	*x = CreateNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteResponse) ProtoMessage() {}
+
+func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateNoteResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateNoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CreateNoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CreateNoteResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateNoteResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CreateNoteResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateNoteResponse) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *CreateNoteResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *CreateNoteResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// Bulk create messages
+// The client streams an optional options message followed by the notes, then
+// closes the stream; the server replies once with a result per note in the
+// order they were sent. Notes are validated individually (same rules as
+// CreateNote), so a bad note does not fail the stream. At most 10000 notes
+// per stream.
+type BulkCreateNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BulkCreateNotesRequest_Options
+	//	*BulkCreateNotesRequest_Note
+	Payload       isBulkCreateNotesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateNotesRequest) Reset() {
+	*x = BulkCreateNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateNotesRequest) ProtoMessage() {}
+
+func (x *BulkCreateNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateNotesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BulkCreateNotesRequest) GetPayload() isBulkCreateNotesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *BulkCreateNotesRequest) GetOptions() *BulkCreateNotesOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*BulkCreateNotesRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *BulkCreateNotesRequest) GetNote() *BulkCreateNoteItem {
+	if x != nil {
+		if x, ok := x.Payload.(*BulkCreateNotesRequest_Note); ok {
+			return x.Note
+		}
+	}
+	return nil
+}
+
+type isBulkCreateNotesRequest_Payload interface {
+	isBulkCreateNotesRequest_Payload()
+}
+
+type BulkCreateNotesRequest_Options struct {
+	// Must be the first message when present.
+	Options *BulkCreateNotesOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type BulkCreateNotesRequest_Note struct {
+	Note *BulkCreateNoteItem `protobuf:"bytes,2,opt,name=note,proto3,oneof"`
+}
+
+func (*BulkCreateNotesRequest_Options) isBulkCreateNotesRequest_Payload() {}
+
+func (*BulkCreateNotesRequest_Note) isBulkCreateNotesRequest_Payload() {}
+
+type BulkCreateNotesOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          BulkCreateMode         `protobuf:"varint,1,opt,name=mode,proto3,enum=go_test.v1.BulkCreateMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateNotesOptions) Reset() {
+	*x = BulkCreateNotesOptions{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateNotesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateNotesOptions) ProtoMessage() {}
+
+func (x *BulkCreateNotesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateNotesOptions.ProtoReflect.Descriptor instead.
+func (*BulkCreateNotesOptions) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BulkCreateNotesOptions) GetMode() BulkCreateMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkCreateMode_BULK_CREATE_MODE_UNSPECIFIED
+}
+
+type BulkCreateNoteItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId    int64                  `protobuf:"varint,4,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateNoteItem) Reset() {
+	*x = BulkCreateNoteItem{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateNoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateNoteItem) ProtoMessage() {}
+
+func (x *BulkCreateNoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateNoteItem.ProtoReflect.Descriptor instead.
+func (*BulkCreateNoteItem) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulkCreateNoteItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BulkCreateNoteItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BulkCreateNoteItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BulkCreateNoteItem) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+type BulkCreateNoteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the note in the request stream, starting at 0.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Set when the note was created.
+	Id      int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// google.rpc.Code and message when the note was not created; 0 (OK) otherwise.
+	ErrorCode     int32  `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateNoteResult) Reset() {
+	*x = BulkCreateNoteResult{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateNoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateNoteResult) ProtoMessage() {}
+
+func (x *BulkCreateNoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateNoteResult.ProtoReflect.Descriptor instead.
+func (*BulkCreateNoteResult) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkCreateNoteResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkCreateNoteResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BulkCreateNoteResult) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BulkCreateNoteResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BulkCreateNoteResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BulkCreateNotesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*BulkCreateNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount  int32                   `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	FailedCount   int32                   `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateNotesResponse) Reset() {
+	*x = BulkCreateNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateNotesResponse) ProtoMessage() {}
+
+func (x *BulkCreateNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateNotesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkCreateNotesResponse) GetResults() []*BulkCreateNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkCreateNotesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateNotesResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type GetNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When set, content_html carries the content rendered as HTML (see RenderNote).
+	RenderHtml    bool `protobuf:"varint,2,opt,name=render_html,json=renderHtml,proto3" json:"render_html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRequest) Reset() {
+	*x = GetNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRequest) ProtoMessage() {}
+
+func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetNoteRequest) GetRenderHtml() bool {
+	if x != nil {
+		return x.RenderHtml
+	}
+	return false
+}
+
+type GetNoteResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content    string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version    int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Etag       string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	Tags       []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId int64                  `protobuf:"varint,9,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	Pinned     bool                   `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Archived   bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Only set when render_html was requested.
+	ContentHtml   string `protobuf:"bytes,12,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteResponse) Reset() {
+	*x = GetNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteResponse) ProtoMessage() {}
+
+func (x *GetNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteResponse.ProtoReflect.Descriptor instead.
+func (*GetNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetNoteResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetNoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetNoteResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetNoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetNoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetNoteResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetNoteResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetNoteResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetNoteResponse) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *GetNoteResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *GetNoteResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *GetNoteResponse) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+// Fetches several notes in one call. Duplicate IDs are allowed.
+type BatchGetNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetNotesRequest) Reset() {
+	*x = BatchGetNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNotesRequest) ProtoMessage() {}
+
+func (x *BatchGetNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNotesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetNotesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetNoteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unset when not_found is true.
+	Note *Note `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// The note does not exist or is in the trash.
+	NotFound      bool `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetNoteResult) Reset() {
+	*x = BatchGetNoteResult{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetNoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNoteResult) ProtoMessage() {}
+
+func (x *BatchGetNoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNoteResult.ProtoReflect.Descriptor instead.
+func (*BatchGetNoteResult) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetNoteResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchGetNoteResult) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *BatchGetNoteResult) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+type BatchGetNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per requested ID, in request order.
+	Results       []*BatchGetNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetNotesResponse) Reset() {
+	*x = BatchGetNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNotesResponse) ProtoMessage() {}
+
+func (x *BatchGetNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNotesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetNotesResponse) GetResults() []*BatchGetNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Exactly one of expected_version or etag must be given. The update fails with
+// ABORTED when the note has been modified since that version was read.
+type UpdateNoteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Etag            string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// When set, replaces the tags of the note. An empty list removes all tags.
+	Tags          *TagList `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTags() *TagList {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId    int64                  `protobuf:"varint,9,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Archived      bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteResponse) Reset() {
+	*x = UpdateNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteResponse) ProtoMessage() {}
+
+func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateNoteResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateNoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateNoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UpdateNoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UpdateNoteResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateNoteResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateNoteResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateNoteResponse) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *UpdateNoteResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateNoteResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// Note revision messages
+// A revision is the content of a note at a given version. A new revision is
+// recorded every time the note is created, updated or restored.
+type NoteRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteRevision) Reset() {
+	*x = NoteRevision{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteRevision) ProtoMessage() {}
+
+func (x *NoteRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteRevision.ProtoReflect.Descriptor instead.
+func (*NoteRevision) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NoteRevision) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *NoteRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Revisions are returned newest first.
+type ListNoteRevisionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsRequest) Reset() {
+	*x = ListNoteRevisionsRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsRequest) ProtoMessage() {}
+
+func (x *ListNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListNoteRevisionsRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *ListNoteRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNoteRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNoteRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*NoteRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsResponse) Reset() {
+	*x = ListNoteRevisionsResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsResponse) ProtoMessage() {}
+
+func (x *ListNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListNoteRevisionsResponse) GetRevisions() []*NoteRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListNoteRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetNoteRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionRequest) Reset() {
+	*x = GetNoteRevisionRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionRequest) ProtoMessage() {}
+
+func (x *GetNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNoteRevisionRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *GetNoteRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetNoteRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *NoteRevision          `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionResponse) Reset() {
+	*x = GetNoteRevisionResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionResponse) ProtoMessage() {}
+
+func (x *GetNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetNoteRevisionResponse) GetRevision() *NoteRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Restores the title and content of a revision as a new version of the note.
+// expected_version or etag may be given to guard against concurrent updates.
+type RestoreNoteRevisionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NoteId          int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version         int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Etag            string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionRequest) Reset() {
+	*x = RestoreNoteRevisionRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreNoteRevisionRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type RestoreNoteRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId    int64                  `protobuf:"varint,9,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Archived      bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionResponse) Reset() {
+	*x = RestoreNoteRevisionResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreNoteRevisionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RestoreNoteRevisionResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RestoreNoteRevisionResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RestoreNoteRevisionResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RestoreNoteRevisionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *RestoreNoteRevisionResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RestoreNoteRevisionResponse) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *RestoreNoteRevisionResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type DiffNoteRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NoteId      int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	FromVersion int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// Version to compare against. 0 compares against the current content.
+	ToVersion   int64           `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Granularity DiffGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=go_test.v1.DiffGranularity" json:"granularity,omitempty"`
+	// Unchanged lines (or words) of context around each change. Defaults to 3.
+	ContextSize   *int32 `protobuf:"varint,5,opt,name=context_size,json=contextSize,proto3,oneof" json:"context_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteRequest) Reset() {
+	*x = DiffNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteRequest) ProtoMessage() {}
+
+func (x *DiffNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteRequest.ProtoReflect.Descriptor instead.
+func (*DiffNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiffNoteRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DiffNoteRequest) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffNoteRequest) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffNoteRequest) GetGranularity() DiffGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return DiffGranularity_DIFF_GRANULARITY_UNSPECIFIED
+}
+
+func (x *DiffNoteRequest) GetContextSize() int32 {
+	if x != nil && x.ContextSize != nil {
+		return *x.ContextSize
+	}
+	return 0
+}
+
+type DiffEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffEdit_Op            `protobuf:"varint,1,opt,name=op,proto3,enum=go_test.v1.DiffEdit_Op" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffEdit) Reset() {
+	*x = DiffEdit{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffEdit) ProtoMessage() {}
+
+func (x *DiffEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffEdit.ProtoReflect.Descriptor instead.
+func (*DiffEdit) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DiffEdit) GetOp() DiffEdit_Op {
+	if x != nil {
+		return x.Op
+	}
+	return DiffEdit_OP_UNSPECIFIED
+}
+
+func (x *DiffEdit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Positions are 1-based and counted in lines or words depending on the granularity.
+type DiffHunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldStart      int32                  `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
+	OldCount      int32                  `protobuf:"varint,2,opt,name=old_count,json=oldCount,proto3" json:"old_count,omitempty"`
+	NewStart      int32                  `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewCount      int32                  `protobuf:"varint,4,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	Edits         []*DiffEdit            `protobuf:"bytes,5,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffHunk) Reset() {
+	*x = DiffHunk{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffHunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffHunk) ProtoMessage() {}
+
+func (x *DiffHunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffHunk.ProtoReflect.Descriptor instead.
+func (*DiffHunk) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DiffHunk) GetOldStart() int32 {
+	if x != nil {
+		return x.OldStart
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetOldCount() int32 {
+	if x != nil {
+		return x.OldCount
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetNewStart() int32 {
+	if x != nil {
+		return x.NewStart
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetEdits() []*DiffEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type DiffNoteResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NoteId      int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	FromVersion int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   int64                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	OldTitle    string                 `protobuf:"bytes,4,opt,name=old_title,json=oldTitle,proto3" json:"old_title,omitempty"`
+	NewTitle    string                 `protobuf:"bytes,5,opt,name=new_title,json=newTitle,proto3" json:"new_title,omitempty"`
+	// Unified diff of the content. For word granularity each hunk body marks
+	// changes inline as [-deleted-]{+inserted+}. Empty when the content is unchanged.
+	UnifiedDiff   string      `protobuf:"bytes,6,opt,name=unified_diff,json=unifiedDiff,proto3" json:"unified_diff,omitempty"`
+	Hunks         []*DiffHunk `protobuf:"bytes,7,rep,name=hunks,proto3" json:"hunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteResponse) Reset() {
+	*x = DiffNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteResponse) ProtoMessage() {}
+
+func (x *DiffNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteResponse.ProtoReflect.Descriptor instead.
+func (*DiffNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiffNoteResponse) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DiffNoteResponse) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffNoteResponse) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffNoteResponse) GetOldTitle() string {
+	if x != nil {
+		return x.OldTitle
+	}
+	return ""
+}
+
+func (x *DiffNoteResponse) GetNewTitle() string {
+	if x != nil {
+		return x.NewTitle
+	}
+	return ""
+}
+
+func (x *DiffNoteResponse) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
+func (x *DiffNoteResponse) GetHunks() []*DiffHunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+// Trash messages
+// DeleteNote moves a note to the trash. Trashed notes are hidden from GetNote
+// and are permanently removed after the server's retention period.
+type DeleteNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional guards against deleting a note that was modified concurrently.
+	ExpectedVersion int64  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Etag            string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteNoteRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeleteNoteRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Trashed notes are returned most recently created first.
+type ListTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTrashResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRequest) Reset() {
+	*x = RestoreNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteResponse) Reset() {
+	*x = RestoreNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteResponse) ProtoMessage() {}
+
+func (x *RestoreNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Permanently removes a trashed note together with its history.
+type PurgeNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteRequest) Reset() {
+	*x = PurgeNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteRequest) ProtoMessage() {}
+
+func (x *PurgeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteRequest.ProtoReflect.Descriptor instead.
+func (*PurgeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PurgeNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteResponse) Reset() {
+	*x = PurgeNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteResponse) ProtoMessage() {}
+
+func (x *PurgeNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteResponse.ProtoReflect.Descriptor instead.
+func (*PurgeNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{36}
+}
+
+// Search messages
+// Full-text search over note titles and content. Japanese text is supported
+// (ngram), so queries should be at least two characters long.
+type SearchNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Markers wrapped around matched terms in snippets. Default to "<mark>" and
+	// "</mark>", in which case the rest of the snippet is HTML-escaped and safe
+	// to embed in HTML. With custom markers snippets are returned as plain text
+	// and the client is responsible for escaping them.
+	HighlightPreTag  string `protobuf:"bytes,4,opt,name=highlight_pre_tag,json=highlightPreTag,proto3" json:"highlight_pre_tag,omitempty"`
+	HighlightPostTag string `protobuf:"bytes,5,opt,name=highlight_post_tag,json=highlightPostTag,proto3" json:"highlight_post_tag,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchNotesRequest) Reset() {
+	*x = SearchNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesRequest) ProtoMessage() {}
+
+func (x *SearchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchNotesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetHighlightPreTag() string {
+	if x != nil {
+		return x.HighlightPreTag
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetHighlightPostTag() string {
+	if x != nil {
+		return x.HighlightPostTag
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Note  *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Relevance score; results are ordered by descending score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Title and an excerpt of the content around the first match, with matched
+	// terms wrapped in the highlight tags.
+	TitleSnippet   string `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	ContentSnippet string `protobuf:"bytes,4,opt,name=content_snippet,json=contentSnippet,proto3" json:"content_snippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchResult) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetContentSnippet() string {
+	if x != nil {
+		return x.ContentSnippet
+	}
+	return ""
+}
+
+type SearchNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesResponse) Reset() {
+	*x = SearchNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesResponse) ProtoMessage() {}
+
+func (x *SearchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchNotesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Listing messages
+// Pinned notes are returned first, then the rest; each group most recently
+// created first.
+type ListNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only notes carrying all of these tags are returned. Tags are normalized
+	// the same way as on write.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Only notes filed directly in this notebook are returned, or also those in
+	// its descendant notebooks when include_descendants is set. 0 lists all notes.
+	NotebookId         int64 `protobuf:"varint,4,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	IncludeDescendants bool  `protobuf:"varint,5,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	// Archived notes are excluded unless set.
+	IncludeArchived bool `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListNotesRequest) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *ListNotesRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListNotesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{42}
+}
+
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of notes outside the trash carrying the tag.
+	NoteCount     int64 `protobuf:"varint,2,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetNoteCount() int64 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+// Tags are returned by descending note count, then by name.
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Notebook messages
+// Notebooks are nestable folders. A note is filed in at most one notebook.
+type Notebook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 for top-level notebooks.
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notebook) Reset() {
+	*x = Notebook{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notebook) ProtoMessage() {}
+
+func (x *Notebook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notebook.ProtoReflect.Descriptor instead.
+func (*Notebook) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Notebook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notebook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Notebook) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Notebook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notebook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 creates a top-level notebook.
+	ParentId      int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookRequest) Reset() {
+	*x = CreateNotebookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookRequest) ProtoMessage() {}
+
+func (x *CreateNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateNotebookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNotebookRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type CreateNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebook      *Notebook              `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookResponse) Reset() {
+	*x = CreateNotebookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookResponse) ProtoMessage() {}
+
+func (x *CreateNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+type GetNotebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotebookRequest) Reset() {
+	*x = GetNotebookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotebookRequest) ProtoMessage() {}
+
+func (x *GetNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotebookRequest.ProtoReflect.Descriptor instead.
+func (*GetNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetNotebookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebook      *Notebook              `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotebookResponse) Reset() {
+	*x = GetNotebookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotebookResponse) ProtoMessage() {}
+
+func (x *GetNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotebookResponse.ProtoReflect.Descriptor instead.
+func (*GetNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// Renames and/or moves a notebook. Moving a notebook under itself or one of
+// its descendants fails with FAILED_PRECONDITION.
+type UpdateNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 moves the notebook to the top level.
+	ParentId      int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotebookRequest) Reset() {
+	*x = UpdateNotebookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotebookRequest) ProtoMessage() {}
+
+func (x *UpdateNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotebookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateNotebookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateNotebookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNotebookRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type UpdateNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebook      *Notebook              `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotebookResponse) Reset() {
+	*x = UpdateNotebookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotebookResponse) ProtoMessage() {}
+
+func (x *UpdateNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotebookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// Only empty notebooks can be deleted: deleting a notebook that still has
+// child notebooks or notes outside the trash fails with FAILED_PRECONDITION.
+type DeleteNotebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookRequest) Reset() {
+	*x = DeleteNotebookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookRequest) ProtoMessage() {}
+
+func (x *DeleteNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteNotebookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookResponse) Reset() {
+	*x = DeleteNotebookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookResponse) ProtoMessage() {}
+
+func (x *DeleteNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{53}
+}
+
+// Lists the direct children of a notebook in creation order.
+type ListNotebooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 lists the top-level notebooks.
+	ParentId int64 `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksRequest) Reset() {
+	*x = ListNotebooksRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksRequest) ProtoMessage() {}
+
+func (x *ListNotebooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksRequest.ProtoReflect.Descriptor instead.
+func (*ListNotebooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListNotebooksRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListNotebooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotebooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNotebooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebooks     []*Notebook            `protobuf:"bytes,1,rep,name=notebooks,proto3" json:"notebooks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksResponse) Reset() {
+	*x = ListNotebooksResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksResponse) ProtoMessage() {}
+
+func (x *ListNotebooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksResponse.ProtoReflect.Descriptor instead.
+func (*ListNotebooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListNotebooksResponse) GetNotebooks() []*Notebook {
+	if x != nil {
+		return x.Notebooks
+	}
+	return nil
+}
+
+func (x *ListNotebooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Files a note in a notebook. Moving does not change the note's version.
+type MoveNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 0 removes the note from its notebook.
+	NotebookId    int64 `protobuf:"varint,2,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveNoteRequest) Reset() {
+	*x = MoveNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNoteRequest) ProtoMessage() {}
+
+func (x *MoveNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNoteRequest.ProtoReflect.Descriptor instead.
+func (*MoveNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MoveNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveNoteRequest) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+type MoveNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveNoteResponse) Reset() {
+	*x = MoveNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNoteResponse) ProtoMessage() {}
+
+func (x *MoveNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNoteResponse.ProtoReflect.Descriptor instead.
+func (*MoveNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MoveNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Pin and archive messages
+// Pinning and archiving do not change the note's version.
+type PinNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// false unpins the note. Archived notes cannot be pinned (FAILED_PRECONDITION).
+	Pinned        bool `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinNoteRequest) Reset() {
+	*x = PinNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinNoteRequest) ProtoMessage() {}
+
+func (x *PinNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinNoteRequest.ProtoReflect.Descriptor instead.
+func (*PinNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PinNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PinNoteRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type PinNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinNoteResponse) Reset() {
+	*x = PinNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinNoteResponse) ProtoMessage() {}
+
+func (x *PinNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinNoteResponse.ProtoReflect.Descriptor instead.
+func (*PinNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PinNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type ArchiveNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// false unarchives the note. Archiving a note also unpins it.
+	Archived      bool `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveNoteRequest) Reset() {
+	*x = ArchiveNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNoteRequest) ProtoMessage() {}
+
+func (x *ArchiveNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNoteRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ArchiveNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArchiveNoteRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveNoteResponse) Reset() {
+	*x = ArchiveNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNoteResponse) ProtoMessage() {}
+
+func (x *ArchiveNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNoteResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ArchiveNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Render messages
+// Renders the content as CommonMark with the GitHub Flavored Markdown
+// extensions (tables, strikethrough, autolinks, task lists). Inline HTML is
+// kept but the output is passed through an allowlist sanitizer, so it is
+// safe to embed in a page. Results are cached per note version.
+type RenderNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderNoteRequest) Reset() {
+	*x = RenderNoteRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderNoteRequest) ProtoMessage() {}
+
+func (x *RenderNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderNoteRequest.ProtoReflect.Descriptor instead.
+func (*RenderNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RenderNoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RenderNoteResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Version of the note that was rendered.
+	Version       int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Html          string `protobuf:"bytes,4,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderNoteResponse) Reset() {
+	*x = RenderNoteResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderNoteResponse) ProtoMessage() {}
+
+func (x *RenderNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderNoteResponse.ProtoReflect.Descriptor instead.
+func (*RenderNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RenderNoteResponse) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *RenderNoteResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RenderNoteResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *RenderNoteResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+// Link messages
+// Notes link to other notes with [[note-id]] or [[title]] (optionally
+// [[target|label]]) in their content. Links inside fenced code blocks are
+// ignored. Links are recorded whenever the content is written and removed
+// while the note is in the trash. Title links are matched exactly (case
+// and width are significant) against current note titles when read.
+type NoteLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Link target as written, without the label.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Resolved note; 0 when no note outside the trash matches the target.
+	// A title matching several notes resolves to the oldest one.
+	TargetNoteId  int64  `protobuf:"varint,2,opt,name=target_note_id,json=targetNoteId,proto3" json:"target_note_id,omitempty"`
+	TargetTitle   string `protobuf:"bytes,3,opt,name=target_title,json=targetTitle,proto3" json:"target_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteLink) Reset() {
+	*x = NoteLink{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteLink) ProtoMessage() {}
+
+func (x *NoteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteLink.ProtoReflect.Descriptor instead.
+func (*NoteLink) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *NoteLink) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NoteLink) GetTargetNoteId() int64 {
+	if x != nil {
+		return x.TargetNoteId
+	}
+	return 0
+}
+
+func (x *NoteLink) GetTargetTitle() string {
+	if x != nil {
+		return x.TargetTitle
+	}
+	return ""
+}
+
+// Notes linking to the given note, most recently created first. A title link
+// counts only for the note it resolves to, i.e. the oldest note with that title.
+type GetBacklinksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBacklinksRequest) Reset() {
+	*x = GetBacklinksRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBacklinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBacklinksRequest) ProtoMessage() {}
+
+func (x *GetBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*GetBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBacklinksRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *GetBacklinksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetBacklinksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetBacklinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBacklinksResponse) Reset() {
+	*x = GetBacklinksResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBacklinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBacklinksResponse) ProtoMessage() {}
+
+func (x *GetBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*GetBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetBacklinksResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *GetBacklinksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Links in the content of the given note, in order of appearance.
+type GetOutgoingLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutgoingLinksRequest) Reset() {
+	*x = GetOutgoingLinksRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutgoingLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutgoingLinksRequest) ProtoMessage() {}
+
+func (x *GetOutgoingLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutgoingLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetOutgoingLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetOutgoingLinksRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+type GetOutgoingLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*NoteLink            `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutgoingLinksResponse) Reset() {
+	*x = GetOutgoingLinksResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutgoingLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutgoingLinksResponse) ProtoMessage() {}
+
+func (x *GetOutgoingLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutgoingLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetOutgoingLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetOutgoingLinksResponse) GetLinks() []*NoteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Template messages
+// Title and content templates use Go text/template syntax with:
+//
+//	{{.Date}}        current date (2006-01-02)
+//	{{.Time}}        current time (15:04)
+//	{{.Author}}      authenticated principal creating the note (empty when
+//	                 the request is not authenticated)
+//	{{.Vars.name}}   custom variable; {{var "name"}} is equivalent
+//
+// and only these functions: date (format the current time with a Go layout),
+// var, upper, lower, trim, default, and, or, not, eq, ne, lt, le, gt, ge, len
+// and index. range is only allowed over .Vars and cannot be nested. Templates
+// using other functions or constructs are rejected with INVALID_ARGUMENT.
+// Rendering is aborted after one second.
+type NoteTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TitleTemplate   string                 `protobuf:"bytes,3,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	ContentTemplate string                 `protobuf:"bytes,4,opt,name=content_template,json=contentTemplate,proto3" json:"content_template,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NoteTemplate) Reset() {
+	*x = NoteTemplate{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteTemplate) ProtoMessage() {}
+
+func (x *NoteTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteTemplate.ProtoReflect.Descriptor instead.
+func (*NoteTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *NoteTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NoteTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NoteTemplate) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *NoteTemplate) GetContentTemplate() string {
+	if x != nil {
+		return x.ContentTemplate
+	}
+	return ""
+}
+
+func (x *NoteTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateNoteTemplateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TitleTemplate   string                 `protobuf:"bytes,2,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	ContentTemplate string                 `protobuf:"bytes,3,opt,name=content_template,json=contentTemplate,proto3" json:"content_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateNoteTemplateRequest) Reset() {
+	*x = CreateNoteTemplateRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteTemplateRequest) ProtoMessage() {}
+
+func (x *CreateNoteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateNoteTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNoteTemplateRequest) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *CreateNoteTemplateRequest) GetContentTemplate() string {
+	if x != nil {
+		return x.ContentTemplate
+	}
+	return ""
+}
+
+type CreateNoteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *NoteTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteTemplateResponse) Reset() {
+	*x = CreateNoteTemplateResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteTemplateResponse) ProtoMessage() {}
+
+func (x *CreateNoteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateNoteTemplateResponse) GetTemplate() *NoteTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetNoteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteTemplateRequest) Reset() {
+	*x = GetNoteTemplateRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteTemplateRequest) ProtoMessage() {}
+
+func (x *GetNoteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetNoteTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetNoteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *NoteTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteTemplateResponse) Reset() {
+	*x = GetNoteTemplateResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteTemplateResponse) ProtoMessage() {}
+
+func (x *GetNoteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetNoteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetNoteTemplateResponse) GetTemplate() *NoteTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateNoteTemplateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TitleTemplate   string                 `protobuf:"bytes,3,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	ContentTemplate string                 `protobuf:"bytes,4,opt,name=content_template,json=contentTemplate,proto3" json:"content_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateNoteTemplateRequest) Reset() {
+	*x = UpdateNoteTemplateRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateNoteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateNoteTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateNoteTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNoteTemplateRequest) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *UpdateNoteTemplateRequest) GetContentTemplate() string {
+	if x != nil {
+		return x.ContentTemplate
+	}
+	return ""
+}
+
+type UpdateNoteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *NoteTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteTemplateResponse) Reset() {
+	*x = UpdateNoteTemplateResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateNoteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNoteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateNoteTemplateResponse) GetTemplate() *NoteTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// Notes already created from the template are not affected.
+type DeleteNoteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteTemplateRequest) Reset() {
+	*x = DeleteNoteTemplateRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteNoteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteNoteTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteNoteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteTemplateResponse) Reset() {
+	*x = DeleteNoteTemplateResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteNoteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{77}
+}
+
+// Templates are returned in creation order.
+type ListNoteTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteTemplatesRequest) Reset() {
+	*x = ListNoteTemplatesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteTemplatesRequest) ProtoMessage() {}
+
+func (x *ListNoteTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListNoteTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNoteTemplatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNoteTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*NoteTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteTemplatesResponse) Reset() {
+	*x = ListNoteTemplatesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteTemplatesResponse) ProtoMessage() {}
+
+func (x *ListNoteTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListNoteTemplatesResponse) GetTemplates() []*NoteTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListNoteTemplatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Renders the template and creates a note from the result. Referencing a
+// variable that is not given fails with INVALID_ARGUMENT.
+type CreateNoteFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// At most 100 variables.
+	Variables map[string]string `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Ignored; {{.Author}} is the authenticated principal.
+	//
+	// Deprecated: Marked as deprecated in proto/go_test/v1/go_test.proto.
+	Author     string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Tags       []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	NotebookId int64    `protobuf:"varint,5,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Same as CreateNoteRequest.idempotency_key.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateNoteFromTemplateRequest) Reset() {
+	*x = CreateNoteFromTemplateRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateNoteFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateNoteFromTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateNoteFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/go_test/v1/go_test.proto.
+func (x *CreateNoteFromTemplateRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CreateNoteFromTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateNoteFromTemplateRequest) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+func (x *CreateNoteFromTemplateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateNoteFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteFromTemplateResponse) Reset() {
+	*x = CreateNoteFromTemplateResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateNoteFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateNoteFromTemplateResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Attachment messages
+// Attachment content is stored once per SHA-256 digest and shared between
+// attachments with identical content. The content type is sniffed from the
+// first 512 bytes of the content; any type claimed by the client is ignored.
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NoteId      int64                  `protobuf:"varint,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Filename    string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Lowercase hex SHA-256 digest of the content.
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// The first message must carry metadata; every following message carries a
+// chunk of the content. Uploads larger than the server limit
+// (ATTACHMENT_MAX_BYTES) fail with INVALID_ARGUMENT.
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Metadata_
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *UploadAttachmentRequest_Metadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Metadata_); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Metadata_ struct {
+	Metadata *UploadAttachmentRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata_) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	AttachmentId  int64                  `protobuf:"varint,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DownloadAttachmentRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+// The first message carries metadata; every following message carries a
+// chunk of the content.
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Metadata
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetMetadata() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Metadata struct {
+	Metadata *Attachment `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Metadata) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+// Attachments of a note, oldest first.
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListAttachmentsRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	AttachmentId  int64                  `protobuf:"varint,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteAttachmentRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{90}
+}
+
+// Comment messages
+// Adding, editing and deleting comments require an "authorization: Bearer
+// <token>" metadata entry; the authenticated principal is recorded as the
+// author. Requests without it fail with UNAUTHENTICATED, and only the author
+// may edit or delete a comment (PERMISSION_DENIED otherwise).
+type Comment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NoteId int64                  `protobuf:"varint,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Comment this one replies to; 0 for a top-level comment.
+	ParentId int64  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author   string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	// Empty once the comment is deleted.
+	Body string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// Number of direct replies, including deleted ones.
+	ReplyCount int64                  `protobuf:"varint,6,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Deleted comments are kept so that their replies stay in the thread.
+	Deleted       bool `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Comment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type AddCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Comment to reply to; 0 for a top-level comment.
+	ParentId      int64  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Body          string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AddCommentRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AddCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// Direct replies to parent_id (top-level comments when 0), oldest first.
+type ListCommentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NoteId   int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	ParentId int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListCommentsRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *EditCommentRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type EditCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentResponse) ProtoMessage() {}
+
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EditCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteCommentRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{99}
+}
+
+type NoteEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque, ordered event ID. Pass the last received ID as after_event_id
+	// to resume after reconnecting.
+	Id     string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   NoteEventType `protobuf:"varint,2,opt,name=type,proto3,enum=go_test.v1.NoteEventType" json:"type,omitempty"`
+	NoteId int64         `protobuf:"varint,3,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Note version after the change.
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteEvent) Reset() {
+	*x = NoteEvent{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteEvent) ProtoMessage() {}
+
+func (x *NoteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteEvent.ProtoReflect.Descriptor instead.
+func (*NoteEvent) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *NoteEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetType() NoteEventType {
+	if x != nil {
+		return x.Type
+	}
+	return NoteEventType_NOTE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *NoteEvent) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *NoteEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Streams note change events until the client cancels. Events from all
+// server replicas are delivered. Only the most recent events (about
+// WATCH_EVENT_RETENTION) are kept for resuming. A client that cannot keep up
+// is disconnected with ABORTED and should resume from its last event ID.
+type WatchNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events of these notes; all notes when empty.
+	NoteIds []int64 `protobuf:"varint,1,rep,packed,name=note_ids,json=noteIds,proto3" json:"note_ids,omitempty"`
+	// Resume after this event; only new events when empty. Fails with
+	// OUT_OF_RANGE when events after it have already been trimmed from the
+	// stream; reload the notes and watch again without after_event_id.
+	AfterEventId  string `protobuf:"bytes,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotesRequest) Reset() {
+	*x = WatchNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotesRequest) ProtoMessage() {}
+
+func (x *WatchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotesRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *WatchNotesRequest) GetNoteIds() []int64 {
+	if x != nil {
+		return x.NoteIds
+	}
+	return nil
+}
+
+func (x *WatchNotesRequest) GetAfterEventId() string {
+	if x != nil {
+		return x.AfterEventId
+	}
+	return ""
+}
+
+// Webhook messages
+// Note events are POSTed as JSON to every active webhook whose event_types
+// include the event type (all types when empty):
+//
+//	{"id": "<event id>", "type": "note.updated", "note_id": 1, "version": 3,
+//	 "occurred_at": "2024-01-01T00:00:00Z"}
+//
+// Each request carries these headers:
+//
+//	X-Webhook-Id         delivery ID (stable across retries)
+//	X-Webhook-Event      event type, e.g. "note.updated"
+//	X-Webhook-Timestamp  Unix time (seconds) when the request was signed
+//	X-Webhook-Signature  "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+//
+// Receivers should verify the signature, reject stale timestamps and
+// de-duplicate by the payload id, as events are delivered at least once.
+// Any 2xx response counts as delivered; anything else (including redirects
+// and timeouts) is retried with exponential backoff until
+// WEBHOOK_MAX_ATTEMPTS is reached.
+// Destinations resolving to loopback, private, link-local or other
+// non-public addresses are refused at connection time unless
+// WEBHOOK_ALLOW_PRIVATE_NETWORKS is set.
+// Managing webhooks requires an "authorization: Bearer <token>" header;
+// requests without it fail with UNAUTHENTICATED.
+type Webhook struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []NoteEventType        `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=go_test.v1.NoteEventType" json:"event_types,omitempty"`
+	// Disabled webhooks receive no new events; pending deliveries are given up.
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []NoteEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute http or https URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types to deliver; all types when empty.
+	EventTypes []NoteEventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=go_test.v1.NoteEventType" json:"event_types,omitempty"`
+	// Signing secret. It is never returned by the API.
+	Secret        string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []NoteEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []NoteEventType        `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=go_test.v1.NoteEventType" json:"event_types,omitempty"`
+	// Leave empty to keep the current secret.
+	Secret        string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Active        bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UpdateWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []NoteEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// Also deletes the webhook's delivery log.
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{110}
+}
+
+// Webhooks are returned in creation order.
+type ListWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListWebhooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Event     *NoteEvent             `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Status    WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=go_test.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts  int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// HTTP status of the last attempt; 0 when no response was received.
+	LastStatusCode int32  `protobuf:"varint,6,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Next attempt for pending deliveries.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEvent() *NoteEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Deliveries are returned newest first.
+type ListWebhookDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Exports notes outside the trash, archived notes included, in ascending ID
+// order.
+type ExportNotesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=go_test.v1.ExportFormat" json:"format,omitempty"`
+	// Only notes updated at or after this time.
+	UpdatedFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	// Only notes updated before this time.
+	UpdatedTo *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	// Only notes carrying all of these tags. Tags are normalized the same way
+	// as on write.
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesRequest) Reset() {
+	*x = ExportNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesRequest) ProtoMessage() {}
+
+func (x *ExportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ExportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ExportNotesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportNotesRequest) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ExportNotesRequest) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ExportNotesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ExportMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggested file name, e.g. "notes-20240101T000000Z.zip".
+	Filename      string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMetadata) Reset() {
+	*x = ExportMetadata{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMetadata) ProtoMessage() {}
+
+func (x *ExportMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMetadata.ProtoReflect.Descriptor instead.
+func (*ExportMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ExportMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ExportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteCount     int64                  `protobuf:"varint,1,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSummary) Reset() {
+	*x = ExportSummary{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSummary) ProtoMessage() {}
+
+func (x *ExportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSummary.ProtoReflect.Descriptor instead.
+func (*ExportSummary) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ExportSummary) GetNoteCount() int64 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+// The first message carries metadata, the following messages carry chunks
+// of the archive, and the last message carries the summary. A stream that
+// ends without a summary is incomplete.
+type ExportNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ExportNotesResponse_Metadata
+	//	*ExportNotesResponse_Chunk
+	//	*ExportNotesResponse_Summary
+	Data          isExportNotesResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesResponse) Reset() {
+	*x = ExportNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesResponse) ProtoMessage() {}
+
+func (x *ExportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ExportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ExportNotesResponse) GetData() isExportNotesResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportNotesResponse) GetMetadata() *ExportMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*ExportNotesResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ExportNotesResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*ExportNotesResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *ExportNotesResponse) GetSummary() *ExportSummary {
+	if x != nil {
+		if x, ok := x.Data.(*ExportNotesResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isExportNotesResponse_Data interface {
+	isExportNotesResponse_Data()
+}
+
+type ExportNotesResponse_Metadata struct {
+	Metadata *ExportMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ExportNotesResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type ExportNotesResponse_Summary struct {
+	Summary *ExportSummary `protobuf:"bytes,3,opt,name=summary,proto3,oneof"`
+}
+
+func (*ExportNotesResponse_Metadata) isExportNotesResponse_Data() {}
+
+func (*ExportNotesResponse_Chunk) isExportNotesResponse_Data() {}
+
+func (*ExportNotesResponse_Summary) isExportNotesResponse_Data() {}
+
+// Import messages
+// Files are parsed by extension:
+//
+//	.md, .markdown  one note; YAML front matter may set title (defaults to
+//	                the file name), created (or date) and tags (or tag)
+//	.enex           Evernote export; content is converted to Markdown and
+//	                resources (attachments) are skipped
+//	.ndjson, .jsonl one note per line (the ExportNotes NDJSON format)
+//	.csv            header row with title and content columns, and optional
+//	                tags (newline-separated) and created_at columns
+//
+// A note whose title and content equal those of a note outside the trash,
+// or of an earlier note in the same stream, is skipped as a duplicate.
+// Each file is imported as soon as its last chunk arrives.
+type ImportNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportNotesRequest_Options
+	//	*ImportNotesRequest_File
+	//	*ImportNotesRequest_Chunk
+	Payload       isImportNotesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNotesRequest) Reset() {
+	*x = ImportNotesRequest{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNotesRequest) ProtoMessage() {}
+
+func (x *ImportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ImportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ImportNotesRequest) GetPayload() isImportNotesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportNotesRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportNotesRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportNotesRequest) GetFile() *ImportFile {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportNotesRequest_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *ImportNotesRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportNotesRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportNotesRequest_Payload interface {
+	isImportNotesRequest_Payload()
+}
+
+type ImportNotesRequest_Options struct {
+	// Must be the first message when present.
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportNotesRequest_File struct {
+	// Starts a new file; its content follows in chunk messages.
+	File *ImportFile `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type ImportNotesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportNotesRequest_Options) isImportNotesRequest_Payload() {}
+
+func (*ImportNotesRequest_File) isImportNotesRequest_Payload() {}
+
+func (*ImportNotesRequest_Chunk) isImportNotesRequest_Payload() {}
+
+type ImportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notebook every imported note is filed in; 0 for none.
+	NotebookId int64 `protobuf:"varint,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Tags added to every imported note, e.g. "imported".
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[0]
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,35 +7909,48 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{121}
 }
 
-type PingResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	MysqlAvailable bool                   `protobuf:"varint,1,opt,name=mysql_available,json=mysqlAvailable,proto3" json:"mysql_available,omitempty"`
-	RedisAvailable bool                   `protobuf:"varint,2,opt,name=redis_available,json=redisAvailable,proto3" json:"redis_available,omitempty"`
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ImportOptions) GetNotebookId() int64 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[1]
+func (x *ImportOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ImportFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Files larger than 32 MiB are rejected.
+	Filename      string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFile) Reset() {
+	*x = ImportFile{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResponse) String() string {
+func (x *ImportFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*ImportFile) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[1]
+func (x *ImportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -93,56 +7961,48 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *PingResponse) GetMysqlAvailable() bool {
-	if x != nil {
-		return x.MysqlAvailable
-	}
-	return false
-}
-
-func (x *PingResponse) GetRedisAvailable() bool {
-	if x != nil {
-		return x.RedisAvailable
-	}
-	return false
+// Deprecated: Use ImportFile.ProtoReflect.Descriptor instead.
+func (*ImportFile) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *PingResponse) GetMessage() string {
+func (x *ImportFile) GetFilename() string {
 	if x != nil {
-		return x.Message
+		return x.Filename
 	}
 	return ""
 }
 
-// Note messages
-type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+type ImportNoteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the note in its file, starting at 0.
+	Index  int32            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Title  string           `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status ImportNoteStatus `protobuf:"varint,3,opt,name=status,proto3,enum=go_test.v1.ImportNoteStatus" json:"status,omitempty"`
+	// The created note, or the existing note for duplicates.
+	NoteId int64 `protobuf:"varint,4,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// google.rpc.Code and message for failed notes; 0 (OK) otherwise.
+	ErrorCode     int32  `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[2]
+func (x *ImportNoteResult) Reset() {
+	*x = ImportNoteResult{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteRequest) String() string {
+func (x *ImportNoteResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (*ImportNoteResult) ProtoMessage() {}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[2]
+func (x *ImportNoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,50 +8013,80 @@ func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ImportNoteResult.ProtoReflect.Descriptor instead.
+func (*ImportNoteResult) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *CreateNoteRequest) GetTitle() string {
+func (x *ImportNoteResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportNoteResult) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateNoteRequest) GetContent() string {
+func (x *ImportNoteResult) GetStatus() ImportNoteStatus {
 	if x != nil {
-		return x.Content
+		return x.Status
+	}
+	return ImportNoteStatus_IMPORT_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *ImportNoteResult) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *ImportNoteResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ImportNoteResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type CreateNoteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+type ImportFileResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// google.rpc.Code and message when the file could not be parsed or
+	// imported as a whole; notes is empty then.
+	ErrorCode     int32               `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string              `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Notes         []*ImportNoteResult `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNoteResponse) Reset() {
-	*x = CreateNoteResponse{}
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[3]
+func (x *ImportFileResult) Reset() {
+	*x = ImportFileResult{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteResponse) String() string {
+func (x *ImportFileResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteResponse) ProtoMessage() {}
+func (*ImportFileResult) ProtoMessage() {}
 
-func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[3]
+func (x *ImportFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -207,61 +8097,66 @@ func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
-func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ImportFileResult.ProtoReflect.Descriptor instead.
+func (*ImportFileResult) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *CreateNoteResponse) GetId() int64 {
+func (x *ImportFileResult) GetFilename() string {
 	if x != nil {
-		return x.Id
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateNoteResponse) GetTitle() string {
+func (x *ImportFileResult) GetErrorCode() int32 {
 	if x != nil {
-		return x.Title
+		return x.ErrorCode
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateNoteResponse) GetContent() string {
+func (x *ImportFileResult) GetErrorMessage() string {
 	if x != nil {
-		return x.Content
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *CreateNoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ImportFileResult) GetNotes() []*ImportNoteResult {
 	if x != nil {
-		return x.CreatedAt
+		return x.Notes
 	}
 	return nil
 }
 
-type GetNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ImportNotesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Files          []*ImportFileResult    `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	CreatedCount   int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// Files that could not be parsed or imported.
+	FailedFileCount int32 `protobuf:"varint,5,opt,name=failed_file_count,json=failedFileCount,proto3" json:"failed_file_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetNoteRequest) Reset() {
-	*x = GetNoteRequest{}
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[4]
+func (x *ImportNotesResponse) Reset() {
+	*x = ImportNotesResponse{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNoteRequest) String() string {
+func (x *ImportNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNoteRequest) ProtoMessage() {}
+func (*ImportNotesResponse) ProtoMessage() {}
 
-func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[4]
+func (x *ImportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,43 +8167,69 @@ func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNoteRequest.ProtoReflect.Descriptor instead.
-func (*GetNoteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ImportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ImportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetNoteRequest) GetId() int64 {
+func (x *ImportNotesResponse) GetFiles() []*ImportFileResult {
 	if x != nil {
-		return x.Id
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ImportNotesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
 	}
 	return 0
 }
 
-type GetNoteResponse struct {
+func (x *ImportNotesResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetFailedFileCount() int32 {
+	if x != nil {
+		return x.FailedFileCount
+	}
+	return 0
+}
+
+type UploadAttachmentRequest_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNoteResponse) Reset() {
-	*x = GetNoteResponse{}
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[5]
+func (x *UploadAttachmentRequest_Metadata) Reset() {
+	*x = UploadAttachmentRequest_Metadata{}
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNoteResponse) String() string {
+func (x *UploadAttachmentRequest_Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNoteResponse) ProtoMessage() {}
+func (*UploadAttachmentRequest_Metadata) ProtoMessage() {}
 
-func (x *GetNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[5]
+func (x *UploadAttachmentRequest_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_go_test_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,72 +8240,716 @@ func (x *GetNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNoteResponse.ProtoReflect.Descriptor instead.
-func (*GetNoteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UploadAttachmentRequest_Metadata.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest_Metadata) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_go_test_proto_rawDescGZIP(), []int{83, 0}
 }
 
-func (x *GetNoteResponse) GetId() int64 {
+func (x *UploadAttachmentRequest_Metadata) GetNoteId() int64 {
 	if x != nil {
-		return x.Id
+		return x.NoteId
 	}
 	return 0
 }
 
-func (x *GetNoteResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *GetNoteResponse) GetContent() string {
+func (x *UploadAttachmentRequest_Metadata) GetFilename() string {
 	if x != nil {
-		return x.Content
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *GetNoteResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
 var File_proto_go_test_v1_go_test_proto protoreflect.FileDescriptor
 
 const file_proto_go_test_v1_go_test_proto_rawDesc = "" +
 	"\n" +
 	"\x1eproto/go_test/v1/go_test.proto\x12\n" +
-	"go_test.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\r\n" +
+	"go_test.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fproto/go_test/v1/validate.proto\"\r\n" +
 	"\vPingRequest\"z\n" +
 	"\fPingResponse\x12'\n" +
 	"\x0fmysql_available\x18\x01 \x01(\bR\x0emysqlAvailable\x12'\n" +
 	"\x0fredis_available\x18\x02 \x01(\bR\x0eredisAvailable\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"C\n" +
-	"\x11CreateNoteRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"\x8f\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x8e\x03\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\n" +
+	" \x01(\x03R\n" +
+	"notebookId\x12\x16\n" +
+	"\x06pinned\x18\v \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\f \x01(\bR\barchived\"/\n" +
+	"\aTagList\x12$\n" +
+	"\x04tags\x18\x01 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\"\xf7\x01\n" +
+	"\x11CreateNoteRequest\x12!\n" +
+	"\x05title\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x05title\x12&\n" +
+	"\acontent\x18\x02 \x01(\tB\f\xc2\xf3\x18\b\x12\x06\b\x01\x18\xff\xff\x03R\acontent\x12F\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tB\x1d\xc2\xf3\x18\x19\x12\x17\x10\x80\x01\"\x12^[A-Za-z0-9._:-]*$R\x0eidempotencyKey\x12$\n" +
+	"\x04tags\x18\x04 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\x12)\n" +
+	"\vnotebook_id\x18\x05 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\n" +
+	"notebookId\"\xe1\x02\n" +
 	"\x12CreateNoteResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\" \n" +
-	"\x0eGetNoteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x8c\x01\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\t \x01(\x03R\n" +
+	"notebookId\x12\x16\n" +
+	"\x06pinned\x18\n" +
+	" \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\"\x99\x01\n" +
+	"\x16BulkCreateNotesRequest\x12>\n" +
+	"\aoptions\x18\x01 \x01(\v2\".go_test.v1.BulkCreateNotesOptionsH\x00R\aoptions\x124\n" +
+	"\x04note\x18\x02 \x01(\v2\x1e.go_test.v1.BulkCreateNoteItemH\x00R\x04noteB\t\n" +
+	"\apayload\"R\n" +
+	"\x16BulkCreateNotesOptions\x128\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1a.go_test.v1.BulkCreateModeB\b\xc2\xf3\x18\x04*\x02\b\x01R\x04mode\"y\n" +
+	"\x12BulkCreateNoteItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\x04 \x01(\x03R\n" +
+	"notebookId\"\x9a\x01\n" +
+	"\x14BulkCreateNoteResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x9d\x01\n" +
+	"\x17BulkCreateNotesResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .go_test.v1.BulkCreateNoteResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"K\n" +
+	"\x0eGetNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x1f\n" +
+	"\vrender_html\x18\x02 \x01(\bR\n" +
+	"renderHtml\"\x81\x03\n" +
 	"\x0fGetNoteResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2\xdb\x01\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\t \x01(\x03R\n" +
+	"notebookId\x12\x16\n" +
+	"\x06pinned\x18\n" +
+	" \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\x12!\n" +
+	"\fcontent_html\x18\f \x01(\tR\vcontentHtml\":\n" +
+	"\x14BatchGetNotesRequest\x12\"\n" +
+	"\x03ids\x18\x01 \x03(\x03B\x10\xc2\xf3\x18\f2\n" +
+	"\b\x01\x10d\"\x04\"\x02\b\x00R\x03ids\"g\n" +
+	"\x12BatchGetNoteResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x04note\x18\x02 \x01(\v2\x10.go_test.v1.NoteR\x04note\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x01(\bR\bnotFound\"Q\n" +
+	"\x15BatchGetNotesResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.go_test.v1.BatchGetNoteResultR\aresults\"\xf4\x01\n" +
+	"\x11UpdateNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12!\n" +
+	"\x05title\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x05title\x12&\n" +
+	"\acontent\x18\x03 \x01(\tB\f\xc2\xf3\x18\b\x12\x06\b\x01\x18\xff\xff\x03R\acontent\x123\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\x0fexpectedVersion\x12\x1c\n" +
+	"\x04etag\x18\x05 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@R\x04etag\x12'\n" +
+	"\x04tags\x18\x06 \x01(\v2\x13.go_test.v1.TagListR\x04tags\"\xe1\x02\n" +
+	"\x12UpdateNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\t \x01(\x03R\n" +
+	"notebookId\x12\x16\n" +
+	"\x06pinned\x18\n" +
+	" \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\"\xac\x01\n" +
+	"\fNoteRevision\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\x18ListNoteRevisionsRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"{\n" +
+	"\x19ListNoteRevisionsResponse\x126\n" +
+	"\trevisions\x18\x01 \x03(\v2\x18.go_test.v1.NoteRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
+	"\x16GetNoteRevisionRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12\"\n" +
+	"\aversion\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\aversion\"O\n" +
+	"\x17GetNoteRevisionResponse\x124\n" +
+	"\brevision\x18\x01 \x01(\v2\x18.go_test.v1.NoteRevisionR\brevision\"\xb6\x01\n" +
+	"\x1aRestoreNoteRevisionRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12\"\n" +
+	"\aversion\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\aversion\x123\n" +
+	"\x10expected_version\x18\x03 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\x0fexpectedVersion\x12\x1c\n" +
+	"\x04etag\x18\x04 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@R\x04etag\"\xea\x02\n" +
+	"\x1bRestoreNoteRevisionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vnotebook_id\x18\t \x01(\x03R\n" +
+	"notebookId\x12\x16\n" +
+	"\x06pinned\x18\n" +
+	" \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\"\x98\x02\n" +
+	"\x0fDiffNoteRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12+\n" +
+	"\ffrom_version\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\vfromVersion\x12'\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\ttoVersion\x12G\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1b.go_test.v1.DiffGranularityB\b\xc2\xf3\x18\x04*\x02\b\x01R\vgranularity\x122\n" +
+	"\fcontext_size\x18\x05 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dH\x00R\vcontextSize\x88\x01\x01B\x0f\n" +
+	"\r_context_size\"\x8d\x01\n" +
+	"\bDiffEdit\x12'\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x17.go_test.v1.DiffEdit.OpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"D\n" +
+	"\x02Op\x12\x12\n" +
+	"\x0eOP_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bOP_EQUAL\x10\x01\x12\r\n" +
+	"\tOP_DELETE\x10\x02\x12\r\n" +
+	"\tOP_INSERT\x10\x03\"\xaa\x01\n" +
+	"\bDiffHunk\x12\x1b\n" +
+	"\told_start\x18\x01 \x01(\x05R\boldStart\x12\x1b\n" +
+	"\told_count\x18\x02 \x01(\x05R\boldCount\x12\x1b\n" +
+	"\tnew_start\x18\x03 \x01(\x05R\bnewStart\x12\x1b\n" +
+	"\tnew_count\x18\x04 \x01(\x05R\bnewCount\x12*\n" +
+	"\x05edits\x18\x05 \x03(\v2\x14.go_test.v1.DiffEditR\x05edits\"\xf6\x01\n" +
+	"\x10DiffNoteResponse\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x03R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03R\ttoVersion\x12\x1b\n" +
+	"\told_title\x18\x04 \x01(\tR\boldTitle\x12\x1b\n" +
+	"\tnew_title\x18\x05 \x01(\tR\bnewTitle\x12!\n" +
+	"\funified_diff\x18\x06 \x01(\tR\vunifiedDiff\x12*\n" +
+	"\x05hunks\x18\a \x03(\v2\x14.go_test.v1.DiffHunkR\x05hunks\"\x80\x01\n" +
+	"\x11DeleteNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x123\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\x0fexpectedVersion\x12\x1c\n" +
+	"\x04etag\x18\x03 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@R\x04etag\":\n" +
+	"\x12DeleteNoteResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\"e\n" +
+	"\x10ListTrashRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"c\n" +
+	"\x11ListTrashResponse\x12&\n" +
+	"\x05notes\x18\x01 \x03(\v2\x10.go_test.v1.NoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\".\n" +
+	"\x12RestoreNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\";\n" +
+	"\x13RestoreNoteResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\",\n" +
+	"\x10PurgeNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"\x13\n" +
+	"\x11PurgeNoteResponse\"\xf8\x01\n" +
+	"\x12SearchNotesRequest\x12!\n" +
+	"\x05query\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\x02R\x05query\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\x124\n" +
+	"\x11highlight_pre_tag\x18\x04 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10 R\x0fhighlightPreTag\x126\n" +
+	"\x12highlight_post_tag\x18\x05 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10 R\x10highlightPostTag\"\x98\x01\n" +
+	"\fSearchResult\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12#\n" +
+	"\rtitle_snippet\x18\x03 \x01(\tR\ftitleSnippet\x12'\n" +
+	"\x0fcontent_snippet\x18\x04 \x01(\tR\x0econtentSnippet\"q\n" +
+	"\x13SearchNotesResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.go_test.v1.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x92\x02\n" +
+	"\x10ListNotesRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\x12$\n" +
+	"\x04tags\x18\x03 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\x12)\n" +
+	"\vnotebook_id\x18\x04 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\n" +
+	"notebookId\x12/\n" +
+	"\x13include_descendants\x18\x05 \x01(\bR\x12includeDescendants\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\"c\n" +
+	"\x11ListNotesResponse\x12&\n" +
+	"\x05notes\x18\x01 \x03(\v2\x10.go_test.v1.NoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x11\n" +
+	"\x0fListTagsRequest\"=\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x02 \x01(\x03R\tnoteCount\"<\n" +
+	"\x10ListTagsResponse\x12(\n" +
+	"\x04tags\x18\x01 \x03(\v2\x14.go_test.v1.TagCountR\x04tags\"\xc1\x01\n" +
+	"\bNotebook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
+	"\x15CreateNotebookRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x04name\x12%\n" +
+	"\tparent_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\bparentId\"J\n" +
+	"\x16CreateNotebookResponse\x120\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x14.go_test.v1.NotebookR\bnotebook\".\n" +
+	"\x12GetNotebookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"G\n" +
+	"\x13GetNotebookResponse\x120\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x14.go_test.v1.NotebookR\bnotebook\"y\n" +
+	"\x15UpdateNotebookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x1f\n" +
+	"\x04name\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x04name\x12%\n" +
+	"\tparent_id\x18\x03 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\bparentId\"J\n" +
+	"\x16UpdateNotebookResponse\x120\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x14.go_test.v1.NotebookR\bnotebook\"1\n" +
+	"\x15DeleteNotebookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"\x18\n" +
+	"\x16DeleteNotebookResponse\"\x90\x01\n" +
+	"\x14ListNotebooksRequest\x12%\n" +
+	"\tparent_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\bparentId\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"s\n" +
+	"\x15ListNotebooksResponse\x122\n" +
+	"\tnotebooks\x18\x01 \x03(\v2\x14.go_test.v1.NotebookR\tnotebooks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"V\n" +
+	"\x0fMoveNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12)\n" +
+	"\vnotebook_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\n" +
+	"notebookId\"8\n" +
+	"\x10MoveNoteResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\"B\n" +
+	"\x0ePinNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\"7\n" +
+	"\x0fPinNoteResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\"J\n" +
+	"\x12ArchiveNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\bR\barchived\";\n" +
+	"\x13ArchiveNoteResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\"-\n" +
+	"\x11RenderNoteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"o\n" +
+	"\x12RenderNoteResponse\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\x12\x12\n" +
+	"\x04html\x18\x04 \x01(\tR\x04html\"k\n" +
+	"\bNoteLink\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12$\n" +
+	"\x0etarget_note_id\x18\x02 \x01(\x03R\ftargetNoteId\x12!\n" +
+	"\ftarget_title\x18\x03 \x01(\tR\vtargetTitle\"\x8b\x01\n" +
+	"\x13GetBacklinksRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"f\n" +
+	"\x14GetBacklinksResponse\x12&\n" +
+	"\x05notes\x18\x01 \x03(\v2\x10.go_test.v1.NoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\x17GetOutgoingLinksRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\"F\n" +
+	"\x18GetOutgoingLinksResponse\x12*\n" +
+	"\x05links\x18\x01 \x03(\v2\x14.go_test.v1.NoteLinkR\x05links\"\xfa\x01\n" +
+	"\fNoteTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0etitle_template\x18\x03 \x01(\tR\rtitleTemplate\x12)\n" +
+	"\x10content_template\x18\x04 \x01(\tR\x0fcontentTemplate\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x01\n" +
+	"\x19CreateNoteTemplateRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x04name\x122\n" +
+	"\x0etitle_template\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\bR\rtitleTemplate\x127\n" +
+	"\x10content_template\x18\x03 \x01(\tB\f\xc2\xf3\x18\b\x12\x06\b\x01\x18\xff\xff\x03R\x0fcontentTemplate\"R\n" +
+	"\x1aCreateNoteTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.go_test.v1.NoteTemplateR\btemplate\"2\n" +
+	"\x16GetNoteTemplateRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"O\n" +
+	"\x17GetNoteTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.go_test.v1.NoteTemplateR\btemplate\"\xc3\x01\n" +
+	"\x19UpdateNoteTemplateRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x1f\n" +
+	"\x04name\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\x04name\x122\n" +
+	"\x0etitle_template\x18\x03 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\bR\rtitleTemplate\x127\n" +
+	"\x10content_template\x18\x04 \x01(\tB\f\xc2\xf3\x18\b\x12\x06\b\x01\x18\xff\xff\x03R\x0fcontentTemplate\"R\n" +
+	"\x1aUpdateNoteTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.go_test.v1.NoteTemplateR\btemplate\"5\n" +
+	"\x19DeleteNoteTemplateRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"\x1c\n" +
+	"\x1aDeleteNoteTemplateResponse\"m\n" +
+	"\x18ListNoteTemplatesRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"{\n" +
+	"\x19ListNoteTemplatesResponse\x126\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x18.go_test.v1.NoteTemplateR\ttemplates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9e\x03\n" +
+	"\x1dCreateNoteFromTemplateRequest\x12)\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\n" +
+	"templateId\x12V\n" +
+	"\tvariables\x18\x02 \x03(\v28.go_test.v1.CreateNoteFromTemplateRequest.VariablesEntryR\tvariables\x12#\n" +
+	"\x06author\x18\x03 \x01(\tB\v\xc2\xf3\x18\x05\x12\x03\x10\xff\x01\x18\x01R\x06author\x12$\n" +
+	"\x04tags\x18\x04 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\x12)\n" +
+	"\vnotebook_id\x18\x05 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\n" +
+	"notebookId\x12F\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tB\x1d\xc2\xf3\x18\x19\x12\x17\x10\x80\x01\"\x12^[A-Za-z0-9._:-]*$R\x0eidempotencyKey\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x1eCreateNoteFromTemplateResponse\x12$\n" +
+	"\x04note\x18\x01 \x01(\v2\x10.go_test.v1.NoteR\x04note\"\xdb\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\anote_id\x18\x02 \x01(\x03R\x06noteId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdd\x01\n" +
+	"\x17UploadAttachmentRequest\x12J\n" +
+	"\bmetadata\x18\x01 \x01(\v2,.go_test.v1.UploadAttachmentRequest.MetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunk\x1aV\n" +
+	"\bMetadata\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12'\n" +
+	"\bfilename\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\xff\x01R\bfilenameB\x06\n" +
+	"\x04data\"R\n" +
+	"\x18UploadAttachmentResponse\x126\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x16.go_test.v1.AttachmentR\n" +
+	"attachment\"m\n" +
+	"\x19DownloadAttachmentRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12-\n" +
+	"\rattachment_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\fattachmentId\"r\n" +
+	"\x1aDownloadAttachmentResponse\x124\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x16.go_test.v1.AttachmentH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\";\n" +
+	"\x16ListAttachmentsRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\"S\n" +
+	"\x17ListAttachmentsResponse\x128\n" +
+	"\vattachments\x18\x01 \x03(\v2\x16.go_test.v1.AttachmentR\vattachments\"k\n" +
+	"\x17DeleteAttachmentRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12-\n" +
+	"\rattachment_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\fattachmentId\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"\xac\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\anote_id\x18\x02 \x01(\x03R\x06noteId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1f\n" +
+	"\vreply_count\x18\x06 \x01(\x03R\n" +
+	"replyCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\adeleted\x18\t \x01(\bR\adeleted\"~\n" +
+	"\x11AddCommentRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12%\n" +
+	"\tparent_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\bparentId\x12\x1f\n" +
+	"\x04body\x18\x03 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x90NR\x04body\"C\n" +
+	"\x12AddCommentResponse\x12-\n" +
+	"\acomment\x18\x01 \x01(\v2\x13.go_test.v1.CommentR\acomment\"\xb2\x01\n" +
+	"\x13ListCommentsRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12%\n" +
+	"\tparent_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\bparentId\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"o\n" +
+	"\x14ListCommentsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.go_test.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x12EditCommentRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12'\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\tcommentId\x12\x1f\n" +
+	"\x04body\x18\x03 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x90NR\x04body\"D\n" +
+	"\x13EditCommentResponse\x12-\n" +
+	"\acomment\x18\x01 \x01(\v2\x13.go_test.v1.CommentR\acomment\"b\n" +
+	"\x14DeleteCommentRequest\x12!\n" +
+	"\anote_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x06noteId\x12'\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\tcommentId\"\x17\n" +
+	"\x15DeleteCommentResponse\"\xba\x01\n" +
+	"\tNoteEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.go_test.v1.NoteEventTypeR\x04type\x12\x17\n" +
+	"\anote_id\x18\x03 \x01(\x03R\x06noteId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x84\x01\n" +
+	"\x11WatchNotesRequest\x12+\n" +
+	"\bnote_ids\x18\x01 \x03(\x03B\x10\xc2\xf3\x18\f2\n" +
+	"\x10d\x18\x01\"\x04\"\x02\b\x00R\anoteIds\x12B\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\tB\x1c\xc2\xf3\x18\x18\x12\x16\x10@\"\x12^([0-9]+-[0-9]+)?$R\fafterEventId\"\xf5\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12:\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x19.go_test.v1.NoteEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa8\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\x10R\x03url\x12L\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x19.go_test.v1.NoteEventTypeB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x05\x18\x01\"\x04*\x02\b\x01R\n" +
+	"eventTypes\x12#\n" +
+	"\x06secret\x18\x03 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x10\x10\xff\x01R\x06secret\"F\n" +
+	"\x15CreateWebhookResponse\x12-\n" +
+	"\awebhook\x18\x01 \x01(\v2\x13.go_test.v1.WebhookR\awebhook\"-\n" +
+	"\x11GetWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"C\n" +
+	"\x12GetWebhookResponse\x12-\n" +
+	"\awebhook\x18\x01 \x01(\v2\x13.go_test.v1.WebhookR\awebhook\"\xd8\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\x10R\x03url\x12L\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x19.go_test.v1.NoteEventTypeB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x05\x18\x01\"\x04*\x02\b\x01R\n" +
+	"eventTypes\x12!\n" +
+	"\x06secret\x18\x04 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xff\x01R\x06secret\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"F\n" +
+	"\x15UpdateWebhookResponse\x12-\n" +
+	"\awebhook\x18\x01 \x01(\v2\x13.go_test.v1.WebhookR\awebhook\"0\n" +
+	"\x14DeleteWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\"h\n" +
+	"\x13ListWebhooksRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"o\n" +
+	"\x14ListWebhooksResponse\x12/\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x13.go_test.v1.WebhookR\bwebhooks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x03R\twebhookId\x12+\n" +
+	"\x05event\x18\x03 \x01(\v2\x15.go_test.v1.NoteEventR\x05event\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.go_test.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\x06 \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12B\n" +
+	"\x0flast_attempt_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9a\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\b\x00R\twebhookId\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xc2\xf3\x18\x06\x1a\x04\x10\x00 dR\bpageSize\x12(\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x02R\tpageToken\"\x84\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12;\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1b.go_test.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf0\x01\n" +
+	"\x12ExportNotesRequest\x12:\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x18.go_test.v1.ExportFormatB\b\xc2\xf3\x18\x04*\x02\b\x01R\x06format\x12=\n" +
+	"\fupdated_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedFrom\x129\n" +
+	"\n" +
+	"updated_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedTo\x12$\n" +
+	"\x04tags\x18\x04 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\"O\n" +
+	"\x0eExportMetadata\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\".\n" +
+	"\rExportSummary\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x01 \x01(\x03R\tnoteCount\"\xa6\x01\n" +
+	"\x13ExportNotesResponse\x128\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.go_test.v1.ExportMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunk\x125\n" +
+	"\asummary\x18\x03 \x01(\v2\x19.go_test.v1.ExportSummaryH\x00R\asummaryB\x06\n" +
+	"\x04data\"\x9c\x01\n" +
+	"\x12ImportNotesRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.go_test.v1.ImportOptionsH\x00R\aoptions\x12,\n" +
+	"\x04file\x18\x02 \x01(\v2\x16.go_test.v1.ImportFileH\x00R\x04file\x12\x16\n" +
+	"\x05chunk\x18\x03 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"`\n" +
+	"\rImportOptions\x12)\n" +
+	"\vnotebook_id\x18\x01 \x01(\x03B\b\xc2\xf3\x18\x04\"\x02\x10\x00R\n" +
+	"notebookId\x12$\n" +
+	"\x04tags\x18\x02 \x03(\tB\x10\xc2\xf3\x18\f2\n" +
+	"\x10\x14\"\x06\x12\x04\b\x01\x10@R\x04tags\"5\n" +
+	"\n" +
+	"ImportFile\x12'\n" +
+	"\bfilename\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\b\x01\x10\x80\bR\bfilename\"\xd1\x01\n" +
+	"\x10ImportNoteResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.go_test.v1.ImportNoteStatusR\x06status\x12\x17\n" +
+	"\anote_id\x18\x04 \x01(\x03R\x06noteId\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\xa6\x01\n" +
+	"\x10ImportFileResult\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x122\n" +
+	"\x05notes\x18\x04 \x03(\v2\x1c.go_test.v1.ImportNoteResultR\x05notes\"\xe6\x01\n" +
+	"\x13ImportNotesResponse\x122\n" +
+	"\x05files\x18\x01 \x03(\v2\x1c.go_test.v1.ImportFileResultR\x05files\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\x12*\n" +
+	"\x11failed_file_count\x18\x05 \x01(\x05R\x0ffailedFileCount*u\n" +
+	"\x0eBulkCreateMode\x12 \n" +
+	"\x1cBULK_CREATE_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18BULK_CREATE_MODE_PARTIAL\x10\x01\x12#\n" +
+	"\x1fBULK_CREATE_MODE_ALL_OR_NOTHING\x10\x02*i\n" +
+	"\x0fDiffGranularity\x12 \n" +
+	"\x1cDIFF_GRANULARITY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DIFF_GRANULARITY_LINE\x10\x01\x12\x19\n" +
+	"\x15DIFF_GRANULARITY_WORD\x10\x02*\xc1\x01\n" +
+	"\rNoteEventType\x12\x1f\n" +
+	"\x1bNOTE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17NOTE_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17NOTE_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17NOTE_EVENT_TYPE_DELETED\x10\x03\x12\x1c\n" +
+	"\x18NOTE_EVENT_TYPE_RESTORED\x10\x04\x12\x1a\n" +
+	"\x16NOTE_EVENT_TYPE_PURGED\x10\x05*\xb0\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x03*\x9e\x01\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEXPORT_FORMAT_MARKDOWN_ZIP\x10\x01\x12\x1e\n" +
+	"\x1aEXPORT_FORMAT_MARKDOWN_TAR\x10\x02\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x03\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x04*\x97\x01\n" +
+	"\x10ImportNoteStatus\x12\"\n" +
+	"\x1eIMPORT_NOTE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aIMPORT_NOTE_STATUS_CREATED\x10\x01\x12 \n" +
+	"\x1cIMPORT_NOTE_STATUS_DUPLICATE\x10\x02\x12\x1d\n" +
+	"\x19IMPORT_NOTE_STATUS_FAILED\x10\x032\x87\"\n" +
 	"\rGoTestService\x129\n" +
 	"\x04Ping\x12\x17.go_test.v1.PingRequest\x1a\x18.go_test.v1.PingResponse\x12K\n" +
 	"\n" +
-	"CreateNote\x12\x1d.go_test.v1.CreateNoteRequest\x1a\x1e.go_test.v1.CreateNoteResponse\x12B\n" +
-	"\aGetNote\x12\x1a.go_test.v1.GetNoteRequest\x1a\x1b.go_test.v1.GetNoteResponseB\x15Z\x13proto/go_test/v1;v1b\x06proto3"
+	"CreateNote\x12\x1d.go_test.v1.CreateNoteRequest\x1a\x1e.go_test.v1.CreateNoteResponse\x12\\\n" +
+	"\x0fBulkCreateNotes\x12\".go_test.v1.BulkCreateNotesRequest\x1a#.go_test.v1.BulkCreateNotesResponse(\x01\x12B\n" +
+	"\aGetNote\x12\x1a.go_test.v1.GetNoteRequest\x1a\x1b.go_test.v1.GetNoteResponse\x12T\n" +
+	"\rBatchGetNotes\x12 .go_test.v1.BatchGetNotesRequest\x1a!.go_test.v1.BatchGetNotesResponse\x12K\n" +
+	"\n" +
+	"UpdateNote\x12\x1d.go_test.v1.UpdateNoteRequest\x1a\x1e.go_test.v1.UpdateNoteResponse\x12`\n" +
+	"\x11ListNoteRevisions\x12$.go_test.v1.ListNoteRevisionsRequest\x1a%.go_test.v1.ListNoteRevisionsResponse\x12Z\n" +
+	"\x0fGetNoteRevision\x12\".go_test.v1.GetNoteRevisionRequest\x1a#.go_test.v1.GetNoteRevisionResponse\x12f\n" +
+	"\x13RestoreNoteRevision\x12&.go_test.v1.RestoreNoteRevisionRequest\x1a'.go_test.v1.RestoreNoteRevisionResponse\x12E\n" +
+	"\bDiffNote\x12\x1b.go_test.v1.DiffNoteRequest\x1a\x1c.go_test.v1.DiffNoteResponse\x12K\n" +
+	"\n" +
+	"DeleteNote\x12\x1d.go_test.v1.DeleteNoteRequest\x1a\x1e.go_test.v1.DeleteNoteResponse\x12H\n" +
+	"\tListTrash\x12\x1c.go_test.v1.ListTrashRequest\x1a\x1d.go_test.v1.ListTrashResponse\x12N\n" +
+	"\vRestoreNote\x12\x1e.go_test.v1.RestoreNoteRequest\x1a\x1f.go_test.v1.RestoreNoteResponse\x12H\n" +
+	"\tPurgeNote\x12\x1c.go_test.v1.PurgeNoteRequest\x1a\x1d.go_test.v1.PurgeNoteResponse\x12N\n" +
+	"\vSearchNotes\x12\x1e.go_test.v1.SearchNotesRequest\x1a\x1f.go_test.v1.SearchNotesResponse\x12H\n" +
+	"\tListNotes\x12\x1c.go_test.v1.ListNotesRequest\x1a\x1d.go_test.v1.ListNotesResponse\x12E\n" +
+	"\bListTags\x12\x1b.go_test.v1.ListTagsRequest\x1a\x1c.go_test.v1.ListTagsResponse\x12W\n" +
+	"\x0eCreateNotebook\x12!.go_test.v1.CreateNotebookRequest\x1a\".go_test.v1.CreateNotebookResponse\x12N\n" +
+	"\vGetNotebook\x12\x1e.go_test.v1.GetNotebookRequest\x1a\x1f.go_test.v1.GetNotebookResponse\x12W\n" +
+	"\x0eUpdateNotebook\x12!.go_test.v1.UpdateNotebookRequest\x1a\".go_test.v1.UpdateNotebookResponse\x12W\n" +
+	"\x0eDeleteNotebook\x12!.go_test.v1.DeleteNotebookRequest\x1a\".go_test.v1.DeleteNotebookResponse\x12T\n" +
+	"\rListNotebooks\x12 .go_test.v1.ListNotebooksRequest\x1a!.go_test.v1.ListNotebooksResponse\x12E\n" +
+	"\bMoveNote\x12\x1b.go_test.v1.MoveNoteRequest\x1a\x1c.go_test.v1.MoveNoteResponse\x12B\n" +
+	"\aPinNote\x12\x1a.go_test.v1.PinNoteRequest\x1a\x1b.go_test.v1.PinNoteResponse\x12N\n" +
+	"\vArchiveNote\x12\x1e.go_test.v1.ArchiveNoteRequest\x1a\x1f.go_test.v1.ArchiveNoteResponse\x12K\n" +
+	"\n" +
+	"RenderNote\x12\x1d.go_test.v1.RenderNoteRequest\x1a\x1e.go_test.v1.RenderNoteResponse\x12Q\n" +
+	"\fGetBacklinks\x12\x1f.go_test.v1.GetBacklinksRequest\x1a .go_test.v1.GetBacklinksResponse\x12]\n" +
+	"\x10GetOutgoingLinks\x12#.go_test.v1.GetOutgoingLinksRequest\x1a$.go_test.v1.GetOutgoingLinksResponse\x12c\n" +
+	"\x12CreateNoteTemplate\x12%.go_test.v1.CreateNoteTemplateRequest\x1a&.go_test.v1.CreateNoteTemplateResponse\x12Z\n" +
+	"\x0fGetNoteTemplate\x12\".go_test.v1.GetNoteTemplateRequest\x1a#.go_test.v1.GetNoteTemplateResponse\x12c\n" +
+	"\x12UpdateNoteTemplate\x12%.go_test.v1.UpdateNoteTemplateRequest\x1a&.go_test.v1.UpdateNoteTemplateResponse\x12c\n" +
+	"\x12DeleteNoteTemplate\x12%.go_test.v1.DeleteNoteTemplateRequest\x1a&.go_test.v1.DeleteNoteTemplateResponse\x12`\n" +
+	"\x11ListNoteTemplates\x12$.go_test.v1.ListNoteTemplatesRequest\x1a%.go_test.v1.ListNoteTemplatesResponse\x12o\n" +
+	"\x16CreateNoteFromTemplate\x12).go_test.v1.CreateNoteFromTemplateRequest\x1a*.go_test.v1.CreateNoteFromTemplateResponse\x12_\n" +
+	"\x10UploadAttachment\x12#.go_test.v1.UploadAttachmentRequest\x1a$.go_test.v1.UploadAttachmentResponse(\x01\x12e\n" +
+	"\x12DownloadAttachment\x12%.go_test.v1.DownloadAttachmentRequest\x1a&.go_test.v1.DownloadAttachmentResponse0\x01\x12Z\n" +
+	"\x0fListAttachments\x12\".go_test.v1.ListAttachmentsRequest\x1a#.go_test.v1.ListAttachmentsResponse\x12]\n" +
+	"\x10DeleteAttachment\x12#.go_test.v1.DeleteAttachmentRequest\x1a$.go_test.v1.DeleteAttachmentResponse\x12K\n" +
+	"\n" +
+	"AddComment\x12\x1d.go_test.v1.AddCommentRequest\x1a\x1e.go_test.v1.AddCommentResponse\x12Q\n" +
+	"\fListComments\x12\x1f.go_test.v1.ListCommentsRequest\x1a .go_test.v1.ListCommentsResponse\x12N\n" +
+	"\vEditComment\x12\x1e.go_test.v1.EditCommentRequest\x1a\x1f.go_test.v1.EditCommentResponse\x12T\n" +
+	"\rDeleteComment\x12 .go_test.v1.DeleteCommentRequest\x1a!.go_test.v1.DeleteCommentResponse\x12D\n" +
+	"\n" +
+	"WatchNotes\x12\x1d.go_test.v1.WatchNotesRequest\x1a\x15.go_test.v1.NoteEvent0\x01\x12T\n" +
+	"\rCreateWebhook\x12 .go_test.v1.CreateWebhookRequest\x1a!.go_test.v1.CreateWebhookResponse\x12K\n" +
+	"\n" +
+	"GetWebhook\x12\x1d.go_test.v1.GetWebhookRequest\x1a\x1e.go_test.v1.GetWebhookResponse\x12T\n" +
+	"\rUpdateWebhook\x12 .go_test.v1.UpdateWebhookRequest\x1a!.go_test.v1.UpdateWebhookResponse\x12T\n" +
+	"\rDeleteWebhook\x12 .go_test.v1.DeleteWebhookRequest\x1a!.go_test.v1.DeleteWebhookResponse\x12Q\n" +
+	"\fListWebhooks\x12\x1f.go_test.v1.ListWebhooksRequest\x1a .go_test.v1.ListWebhooksResponse\x12l\n" +
+	"\x15ListWebhookDeliveries\x12(.go_test.v1.ListWebhookDeliveriesRequest\x1a).go_test.v1.ListWebhookDeliveriesResponse\x12P\n" +
+	"\vExportNotes\x12\x1e.go_test.v1.ExportNotesRequest\x1a\x1f.go_test.v1.ExportNotesResponse0\x01\x12P\n" +
+	"\vImportNotes\x12\x1e.go_test.v1.ImportNotesRequest\x1a\x1f.go_test.v1.ImportNotesResponse(\x01B\x15Z\x13proto/go_test/v1;v1b\x06proto3"
 
 var (
 	file_proto_go_test_v1_go_test_proto_rawDescOnce sync.Once
@@ -398,30 +8963,343 @@ func file_proto_go_test_v1_go_test_proto_rawDescGZIP() []byte {
 	return file_proto_go_test_v1_go_test_proto_rawDescData
 }
 
-var file_proto_go_test_v1_go_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_go_test_v1_go_test_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_go_test_v1_go_test_proto_msgTypes = make([]protoimpl.MessageInfo, 128)
 var file_proto_go_test_v1_go_test_proto_goTypes = []any{
-	(*PingRequest)(nil),           // 0: go_test.v1.PingRequest
-	(*PingResponse)(nil),          // 1: go_test.v1.PingResponse
-	(*CreateNoteRequest)(nil),     // 2: go_test.v1.CreateNoteRequest
-	(*CreateNoteResponse)(nil),    // 3: go_test.v1.CreateNoteResponse
-	(*GetNoteRequest)(nil),        // 4: go_test.v1.GetNoteRequest
-	(*GetNoteResponse)(nil),       // 5: go_test.v1.GetNoteResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(BulkCreateMode)(0),                      // 0: go_test.v1.BulkCreateMode
+	(DiffGranularity)(0),                     // 1: go_test.v1.DiffGranularity
+	(NoteEventType)(0),                       // 2: go_test.v1.NoteEventType
+	(WebhookDeliveryStatus)(0),               // 3: go_test.v1.WebhookDeliveryStatus
+	(ExportFormat)(0),                        // 4: go_test.v1.ExportFormat
+	(ImportNoteStatus)(0),                    // 5: go_test.v1.ImportNoteStatus
+	(DiffEdit_Op)(0),                         // 6: go_test.v1.DiffEdit.Op
+	(*PingRequest)(nil),                      // 7: go_test.v1.PingRequest
+	(*PingResponse)(nil),                     // 8: go_test.v1.PingResponse
+	(*Note)(nil),                             // 9: go_test.v1.Note
+	(*TagList)(nil),                          // 10: go_test.v1.TagList
+	(*CreateNoteRequest)(nil),                // 11: go_test.v1.CreateNoteRequest
+	(*CreateNoteResponse)(nil),               // 12: go_test.v1.CreateNoteResponse
+	(*BulkCreateNotesRequest)(nil),           // 13: go_test.v1.BulkCreateNotesRequest
+	(*BulkCreateNotesOptions)(nil),           // 14: go_test.v1.BulkCreateNotesOptions
+	(*BulkCreateNoteItem)(nil),               // 15: go_test.v1.BulkCreateNoteItem
+	(*BulkCreateNoteResult)(nil),             // 16: go_test.v1.BulkCreateNoteResult
+	(*BulkCreateNotesResponse)(nil),          // 17: go_test.v1.BulkCreateNotesResponse
+	(*GetNoteRequest)(nil),                   // 18: go_test.v1.GetNoteRequest
+	(*GetNoteResponse)(nil),                  // 19: go_test.v1.GetNoteResponse
+	(*BatchGetNotesRequest)(nil),             // 20: go_test.v1.BatchGetNotesRequest
+	(*BatchGetNoteResult)(nil),               // 21: go_test.v1.BatchGetNoteResult
+	(*BatchGetNotesResponse)(nil),            // 22: go_test.v1.BatchGetNotesResponse
+	(*UpdateNoteRequest)(nil),                // 23: go_test.v1.UpdateNoteRequest
+	(*UpdateNoteResponse)(nil),               // 24: go_test.v1.UpdateNoteResponse
+	(*NoteRevision)(nil),                     // 25: go_test.v1.NoteRevision
+	(*ListNoteRevisionsRequest)(nil),         // 26: go_test.v1.ListNoteRevisionsRequest
+	(*ListNoteRevisionsResponse)(nil),        // 27: go_test.v1.ListNoteRevisionsResponse
+	(*GetNoteRevisionRequest)(nil),           // 28: go_test.v1.GetNoteRevisionRequest
+	(*GetNoteRevisionResponse)(nil),          // 29: go_test.v1.GetNoteRevisionResponse
+	(*RestoreNoteRevisionRequest)(nil),       // 30: go_test.v1.RestoreNoteRevisionRequest
+	(*RestoreNoteRevisionResponse)(nil),      // 31: go_test.v1.RestoreNoteRevisionResponse
+	(*DiffNoteRequest)(nil),                  // 32: go_test.v1.DiffNoteRequest
+	(*DiffEdit)(nil),                         // 33: go_test.v1.DiffEdit
+	(*DiffHunk)(nil),                         // 34: go_test.v1.DiffHunk
+	(*DiffNoteResponse)(nil),                 // 35: go_test.v1.DiffNoteResponse
+	(*DeleteNoteRequest)(nil),                // 36: go_test.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),               // 37: go_test.v1.DeleteNoteResponse
+	(*ListTrashRequest)(nil),                 // 38: go_test.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),                // 39: go_test.v1.ListTrashResponse
+	(*RestoreNoteRequest)(nil),               // 40: go_test.v1.RestoreNoteRequest
+	(*RestoreNoteResponse)(nil),              // 41: go_test.v1.RestoreNoteResponse
+	(*PurgeNoteRequest)(nil),                 // 42: go_test.v1.PurgeNoteRequest
+	(*PurgeNoteResponse)(nil),                // 43: go_test.v1.PurgeNoteResponse
+	(*SearchNotesRequest)(nil),               // 44: go_test.v1.SearchNotesRequest
+	(*SearchResult)(nil),                     // 45: go_test.v1.SearchResult
+	(*SearchNotesResponse)(nil),              // 46: go_test.v1.SearchNotesResponse
+	(*ListNotesRequest)(nil),                 // 47: go_test.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),                // 48: go_test.v1.ListNotesResponse
+	(*ListTagsRequest)(nil),                  // 49: go_test.v1.ListTagsRequest
+	(*TagCount)(nil),                         // 50: go_test.v1.TagCount
+	(*ListTagsResponse)(nil),                 // 51: go_test.v1.ListTagsResponse
+	(*Notebook)(nil),                         // 52: go_test.v1.Notebook
+	(*CreateNotebookRequest)(nil),            // 53: go_test.v1.CreateNotebookRequest
+	(*CreateNotebookResponse)(nil),           // 54: go_test.v1.CreateNotebookResponse
+	(*GetNotebookRequest)(nil),               // 55: go_test.v1.GetNotebookRequest
+	(*GetNotebookResponse)(nil),              // 56: go_test.v1.GetNotebookResponse
+	(*UpdateNotebookRequest)(nil),            // 57: go_test.v1.UpdateNotebookRequest
+	(*UpdateNotebookResponse)(nil),           // 58: go_test.v1.UpdateNotebookResponse
+	(*DeleteNotebookRequest)(nil),            // 59: go_test.v1.DeleteNotebookRequest
+	(*DeleteNotebookResponse)(nil),           // 60: go_test.v1.DeleteNotebookResponse
+	(*ListNotebooksRequest)(nil),             // 61: go_test.v1.ListNotebooksRequest
+	(*ListNotebooksResponse)(nil),            // 62: go_test.v1.ListNotebooksResponse
+	(*MoveNoteRequest)(nil),                  // 63: go_test.v1.MoveNoteRequest
+	(*MoveNoteResponse)(nil),                 // 64: go_test.v1.MoveNoteResponse
+	(*PinNoteRequest)(nil),                   // 65: go_test.v1.PinNoteRequest
+	(*PinNoteResponse)(nil),                  // 66: go_test.v1.PinNoteResponse
+	(*ArchiveNoteRequest)(nil),               // 67: go_test.v1.ArchiveNoteRequest
+	(*ArchiveNoteResponse)(nil),              // 68: go_test.v1.ArchiveNoteResponse
+	(*RenderNoteRequest)(nil),                // 69: go_test.v1.RenderNoteRequest
+	(*RenderNoteResponse)(nil),               // 70: go_test.v1.RenderNoteResponse
+	(*NoteLink)(nil),                         // 71: go_test.v1.NoteLink
+	(*GetBacklinksRequest)(nil),              // 72: go_test.v1.GetBacklinksRequest
+	(*GetBacklinksResponse)(nil),             // 73: go_test.v1.GetBacklinksResponse
+	(*GetOutgoingLinksRequest)(nil),          // 74: go_test.v1.GetOutgoingLinksRequest
+	(*GetOutgoingLinksResponse)(nil),         // 75: go_test.v1.GetOutgoingLinksResponse
+	(*NoteTemplate)(nil),                     // 76: go_test.v1.NoteTemplate
+	(*CreateNoteTemplateRequest)(nil),        // 77: go_test.v1.CreateNoteTemplateRequest
+	(*CreateNoteTemplateResponse)(nil),       // 78: go_test.v1.CreateNoteTemplateResponse
+	(*GetNoteTemplateRequest)(nil),           // 79: go_test.v1.GetNoteTemplateRequest
+	(*GetNoteTemplateResponse)(nil),          // 80: go_test.v1.GetNoteTemplateResponse
+	(*UpdateNoteTemplateRequest)(nil),        // 81: go_test.v1.UpdateNoteTemplateRequest
+	(*UpdateNoteTemplateResponse)(nil),       // 82: go_test.v1.UpdateNoteTemplateResponse
+	(*DeleteNoteTemplateRequest)(nil),        // 83: go_test.v1.DeleteNoteTemplateRequest
+	(*DeleteNoteTemplateResponse)(nil),       // 84: go_test.v1.DeleteNoteTemplateResponse
+	(*ListNoteTemplatesRequest)(nil),         // 85: go_test.v1.ListNoteTemplatesRequest
+	(*ListNoteTemplatesResponse)(nil),        // 86: go_test.v1.ListNoteTemplatesResponse
+	(*CreateNoteFromTemplateRequest)(nil),    // 87: go_test.v1.CreateNoteFromTemplateRequest
+	(*CreateNoteFromTemplateResponse)(nil),   // 88: go_test.v1.CreateNoteFromTemplateResponse
+	(*Attachment)(nil),                       // 89: go_test.v1.Attachment
+	(*UploadAttachmentRequest)(nil),          // 90: go_test.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),         // 91: go_test.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),        // 92: go_test.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),       // 93: go_test.v1.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),           // 94: go_test.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),          // 95: go_test.v1.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),          // 96: go_test.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),         // 97: go_test.v1.DeleteAttachmentResponse
+	(*Comment)(nil),                          // 98: go_test.v1.Comment
+	(*AddCommentRequest)(nil),                // 99: go_test.v1.AddCommentRequest
+	(*AddCommentResponse)(nil),               // 100: go_test.v1.AddCommentResponse
+	(*ListCommentsRequest)(nil),              // 101: go_test.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),             // 102: go_test.v1.ListCommentsResponse
+	(*EditCommentRequest)(nil),               // 103: go_test.v1.EditCommentRequest
+	(*EditCommentResponse)(nil),              // 104: go_test.v1.EditCommentResponse
+	(*DeleteCommentRequest)(nil),             // 105: go_test.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),            // 106: go_test.v1.DeleteCommentResponse
+	(*NoteEvent)(nil),                        // 107: go_test.v1.NoteEvent
+	(*WatchNotesRequest)(nil),                // 108: go_test.v1.WatchNotesRequest
+	(*Webhook)(nil),                          // 109: go_test.v1.Webhook
+	(*CreateWebhookRequest)(nil),             // 110: go_test.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),            // 111: go_test.v1.CreateWebhookResponse
+	(*GetWebhookRequest)(nil),                // 112: go_test.v1.GetWebhookRequest
+	(*GetWebhookResponse)(nil),               // 113: go_test.v1.GetWebhookResponse
+	(*UpdateWebhookRequest)(nil),             // 114: go_test.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),            // 115: go_test.v1.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),             // 116: go_test.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),            // 117: go_test.v1.DeleteWebhookResponse
+	(*ListWebhooksRequest)(nil),              // 118: go_test.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),             // 119: go_test.v1.ListWebhooksResponse
+	(*WebhookDelivery)(nil),                  // 120: go_test.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),     // 121: go_test.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),    // 122: go_test.v1.ListWebhookDeliveriesResponse
+	(*ExportNotesRequest)(nil),               // 123: go_test.v1.ExportNotesRequest
+	(*ExportMetadata)(nil),                   // 124: go_test.v1.ExportMetadata
+	(*ExportSummary)(nil),                    // 125: go_test.v1.ExportSummary
+	(*ExportNotesResponse)(nil),              // 126: go_test.v1.ExportNotesResponse
+	(*ImportNotesRequest)(nil),               // 127: go_test.v1.ImportNotesRequest
+	(*ImportOptions)(nil),                    // 128: go_test.v1.ImportOptions
+	(*ImportFile)(nil),                       // 129: go_test.v1.ImportFile
+	(*ImportNoteResult)(nil),                 // 130: go_test.v1.ImportNoteResult
+	(*ImportFileResult)(nil),                 // 131: go_test.v1.ImportFileResult
+	(*ImportNotesResponse)(nil),              // 132: go_test.v1.ImportNotesResponse
+	nil,                                      // 133: go_test.v1.CreateNoteFromTemplateRequest.VariablesEntry
+	(*UploadAttachmentRequest_Metadata)(nil), // 134: go_test.v1.UploadAttachmentRequest.Metadata
+	(*timestamppb.Timestamp)(nil),            // 135: google.protobuf.Timestamp
 }
 var file_proto_go_test_v1_go_test_proto_depIdxs = []int32{
-	6, // 0: go_test.v1.CreateNoteResponse.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: go_test.v1.GetNoteResponse.created_at:type_name -> google.protobuf.Timestamp
-	0, // 2: go_test.v1.GoTestService.Ping:input_type -> go_test.v1.PingRequest
-	2, // 3: go_test.v1.GoTestService.CreateNote:input_type -> go_test.v1.CreateNoteRequest
-	4, // 4: go_test.v1.GoTestService.GetNote:input_type -> go_test.v1.GetNoteRequest
-	1, // 5: go_test.v1.GoTestService.Ping:output_type -> go_test.v1.PingResponse
-	3, // 6: go_test.v1.GoTestService.CreateNote:output_type -> go_test.v1.CreateNoteResponse
-	5, // 7: go_test.v1.GoTestService.GetNote:output_type -> go_test.v1.GetNoteResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	135, // 0: go_test.v1.Note.created_at:type_name -> google.protobuf.Timestamp
+	135, // 1: go_test.v1.Note.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 2: go_test.v1.Note.deleted_at:type_name -> google.protobuf.Timestamp
+	135, // 3: go_test.v1.CreateNoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	135, // 4: go_test.v1.CreateNoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 5: go_test.v1.BulkCreateNotesRequest.options:type_name -> go_test.v1.BulkCreateNotesOptions
+	15,  // 6: go_test.v1.BulkCreateNotesRequest.note:type_name -> go_test.v1.BulkCreateNoteItem
+	0,   // 7: go_test.v1.BulkCreateNotesOptions.mode:type_name -> go_test.v1.BulkCreateMode
+	16,  // 8: go_test.v1.BulkCreateNotesResponse.results:type_name -> go_test.v1.BulkCreateNoteResult
+	135, // 9: go_test.v1.GetNoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	135, // 10: go_test.v1.GetNoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 11: go_test.v1.BatchGetNoteResult.note:type_name -> go_test.v1.Note
+	21,  // 12: go_test.v1.BatchGetNotesResponse.results:type_name -> go_test.v1.BatchGetNoteResult
+	10,  // 13: go_test.v1.UpdateNoteRequest.tags:type_name -> go_test.v1.TagList
+	135, // 14: go_test.v1.UpdateNoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	135, // 15: go_test.v1.UpdateNoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 16: go_test.v1.NoteRevision.created_at:type_name -> google.protobuf.Timestamp
+	25,  // 17: go_test.v1.ListNoteRevisionsResponse.revisions:type_name -> go_test.v1.NoteRevision
+	25,  // 18: go_test.v1.GetNoteRevisionResponse.revision:type_name -> go_test.v1.NoteRevision
+	135, // 19: go_test.v1.RestoreNoteRevisionResponse.created_at:type_name -> google.protobuf.Timestamp
+	135, // 20: go_test.v1.RestoreNoteRevisionResponse.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 21: go_test.v1.DiffNoteRequest.granularity:type_name -> go_test.v1.DiffGranularity
+	6,   // 22: go_test.v1.DiffEdit.op:type_name -> go_test.v1.DiffEdit.Op
+	33,  // 23: go_test.v1.DiffHunk.edits:type_name -> go_test.v1.DiffEdit
+	34,  // 24: go_test.v1.DiffNoteResponse.hunks:type_name -> go_test.v1.DiffHunk
+	9,   // 25: go_test.v1.DeleteNoteResponse.note:type_name -> go_test.v1.Note
+	9,   // 26: go_test.v1.ListTrashResponse.notes:type_name -> go_test.v1.Note
+	9,   // 27: go_test.v1.RestoreNoteResponse.note:type_name -> go_test.v1.Note
+	9,   // 28: go_test.v1.SearchResult.note:type_name -> go_test.v1.Note
+	45,  // 29: go_test.v1.SearchNotesResponse.results:type_name -> go_test.v1.SearchResult
+	9,   // 30: go_test.v1.ListNotesResponse.notes:type_name -> go_test.v1.Note
+	50,  // 31: go_test.v1.ListTagsResponse.tags:type_name -> go_test.v1.TagCount
+	135, // 32: go_test.v1.Notebook.created_at:type_name -> google.protobuf.Timestamp
+	135, // 33: go_test.v1.Notebook.updated_at:type_name -> google.protobuf.Timestamp
+	52,  // 34: go_test.v1.CreateNotebookResponse.notebook:type_name -> go_test.v1.Notebook
+	52,  // 35: go_test.v1.GetNotebookResponse.notebook:type_name -> go_test.v1.Notebook
+	52,  // 36: go_test.v1.UpdateNotebookResponse.notebook:type_name -> go_test.v1.Notebook
+	52,  // 37: go_test.v1.ListNotebooksResponse.notebooks:type_name -> go_test.v1.Notebook
+	9,   // 38: go_test.v1.MoveNoteResponse.note:type_name -> go_test.v1.Note
+	9,   // 39: go_test.v1.PinNoteResponse.note:type_name -> go_test.v1.Note
+	9,   // 40: go_test.v1.ArchiveNoteResponse.note:type_name -> go_test.v1.Note
+	9,   // 41: go_test.v1.GetBacklinksResponse.notes:type_name -> go_test.v1.Note
+	71,  // 42: go_test.v1.GetOutgoingLinksResponse.links:type_name -> go_test.v1.NoteLink
+	135, // 43: go_test.v1.NoteTemplate.created_at:type_name -> google.protobuf.Timestamp
+	135, // 44: go_test.v1.NoteTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 45: go_test.v1.CreateNoteTemplateResponse.template:type_name -> go_test.v1.NoteTemplate
+	76,  // 46: go_test.v1.GetNoteTemplateResponse.template:type_name -> go_test.v1.NoteTemplate
+	76,  // 47: go_test.v1.UpdateNoteTemplateResponse.template:type_name -> go_test.v1.NoteTemplate
+	76,  // 48: go_test.v1.ListNoteTemplatesResponse.templates:type_name -> go_test.v1.NoteTemplate
+	133, // 49: go_test.v1.CreateNoteFromTemplateRequest.variables:type_name -> go_test.v1.CreateNoteFromTemplateRequest.VariablesEntry
+	9,   // 50: go_test.v1.CreateNoteFromTemplateResponse.note:type_name -> go_test.v1.Note
+	135, // 51: go_test.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	134, // 52: go_test.v1.UploadAttachmentRequest.metadata:type_name -> go_test.v1.UploadAttachmentRequest.Metadata
+	89,  // 53: go_test.v1.UploadAttachmentResponse.attachment:type_name -> go_test.v1.Attachment
+	89,  // 54: go_test.v1.DownloadAttachmentResponse.metadata:type_name -> go_test.v1.Attachment
+	89,  // 55: go_test.v1.ListAttachmentsResponse.attachments:type_name -> go_test.v1.Attachment
+	135, // 56: go_test.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	135, // 57: go_test.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	98,  // 58: go_test.v1.AddCommentResponse.comment:type_name -> go_test.v1.Comment
+	98,  // 59: go_test.v1.ListCommentsResponse.comments:type_name -> go_test.v1.Comment
+	98,  // 60: go_test.v1.EditCommentResponse.comment:type_name -> go_test.v1.Comment
+	2,   // 61: go_test.v1.NoteEvent.type:type_name -> go_test.v1.NoteEventType
+	135, // 62: go_test.v1.NoteEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,   // 63: go_test.v1.Webhook.event_types:type_name -> go_test.v1.NoteEventType
+	135, // 64: go_test.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	135, // 65: go_test.v1.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 66: go_test.v1.CreateWebhookRequest.event_types:type_name -> go_test.v1.NoteEventType
+	109, // 67: go_test.v1.CreateWebhookResponse.webhook:type_name -> go_test.v1.Webhook
+	109, // 68: go_test.v1.GetWebhookResponse.webhook:type_name -> go_test.v1.Webhook
+	2,   // 69: go_test.v1.UpdateWebhookRequest.event_types:type_name -> go_test.v1.NoteEventType
+	109, // 70: go_test.v1.UpdateWebhookResponse.webhook:type_name -> go_test.v1.Webhook
+	109, // 71: go_test.v1.ListWebhooksResponse.webhooks:type_name -> go_test.v1.Webhook
+	107, // 72: go_test.v1.WebhookDelivery.event:type_name -> go_test.v1.NoteEvent
+	3,   // 73: go_test.v1.WebhookDelivery.status:type_name -> go_test.v1.WebhookDeliveryStatus
+	135, // 74: go_test.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	135, // 75: go_test.v1.WebhookDelivery.last_attempt_at:type_name -> google.protobuf.Timestamp
+	135, // 76: go_test.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	135, // 77: go_test.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	120, // 78: go_test.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> go_test.v1.WebhookDelivery
+	4,   // 79: go_test.v1.ExportNotesRequest.format:type_name -> go_test.v1.ExportFormat
+	135, // 80: go_test.v1.ExportNotesRequest.updated_from:type_name -> google.protobuf.Timestamp
+	135, // 81: go_test.v1.ExportNotesRequest.updated_to:type_name -> google.protobuf.Timestamp
+	124, // 82: go_test.v1.ExportNotesResponse.metadata:type_name -> go_test.v1.ExportMetadata
+	125, // 83: go_test.v1.ExportNotesResponse.summary:type_name -> go_test.v1.ExportSummary
+	128, // 84: go_test.v1.ImportNotesRequest.options:type_name -> go_test.v1.ImportOptions
+	129, // 85: go_test.v1.ImportNotesRequest.file:type_name -> go_test.v1.ImportFile
+	5,   // 86: go_test.v1.ImportNoteResult.status:type_name -> go_test.v1.ImportNoteStatus
+	130, // 87: go_test.v1.ImportFileResult.notes:type_name -> go_test.v1.ImportNoteResult
+	131, // 88: go_test.v1.ImportNotesResponse.files:type_name -> go_test.v1.ImportFileResult
+	7,   // 89: go_test.v1.GoTestService.Ping:input_type -> go_test.v1.PingRequest
+	11,  // 90: go_test.v1.GoTestService.CreateNote:input_type -> go_test.v1.CreateNoteRequest
+	13,  // 91: go_test.v1.GoTestService.BulkCreateNotes:input_type -> go_test.v1.BulkCreateNotesRequest
+	18,  // 92: go_test.v1.GoTestService.GetNote:input_type -> go_test.v1.GetNoteRequest
+	20,  // 93: go_test.v1.GoTestService.BatchGetNotes:input_type -> go_test.v1.BatchGetNotesRequest
+	23,  // 94: go_test.v1.GoTestService.UpdateNote:input_type -> go_test.v1.UpdateNoteRequest
+	26,  // 95: go_test.v1.GoTestService.ListNoteRevisions:input_type -> go_test.v1.ListNoteRevisionsRequest
+	28,  // 96: go_test.v1.GoTestService.GetNoteRevision:input_type -> go_test.v1.GetNoteRevisionRequest
+	30,  // 97: go_test.v1.GoTestService.RestoreNoteRevision:input_type -> go_test.v1.RestoreNoteRevisionRequest
+	32,  // 98: go_test.v1.GoTestService.DiffNote:input_type -> go_test.v1.DiffNoteRequest
+	36,  // 99: go_test.v1.GoTestService.DeleteNote:input_type -> go_test.v1.DeleteNoteRequest
+	38,  // 100: go_test.v1.GoTestService.ListTrash:input_type -> go_test.v1.ListTrashRequest
+	40,  // 101: go_test.v1.GoTestService.RestoreNote:input_type -> go_test.v1.RestoreNoteRequest
+	42,  // 102: go_test.v1.GoTestService.PurgeNote:input_type -> go_test.v1.PurgeNoteRequest
+	44,  // 103: go_test.v1.GoTestService.SearchNotes:input_type -> go_test.v1.SearchNotesRequest
+	47,  // 104: go_test.v1.GoTestService.ListNotes:input_type -> go_test.v1.ListNotesRequest
+	49,  // 105: go_test.v1.GoTestService.ListTags:input_type -> go_test.v1.ListTagsRequest
+	53,  // 106: go_test.v1.GoTestService.CreateNotebook:input_type -> go_test.v1.CreateNotebookRequest
+	55,  // 107: go_test.v1.GoTestService.GetNotebook:input_type -> go_test.v1.GetNotebookRequest
+	57,  // 108: go_test.v1.GoTestService.UpdateNotebook:input_type -> go_test.v1.UpdateNotebookRequest
+	59,  // 109: go_test.v1.GoTestService.DeleteNotebook:input_type -> go_test.v1.DeleteNotebookRequest
+	61,  // 110: go_test.v1.GoTestService.ListNotebooks:input_type -> go_test.v1.ListNotebooksRequest
+	63,  // 111: go_test.v1.GoTestService.MoveNote:input_type -> go_test.v1.MoveNoteRequest
+	65,  // 112: go_test.v1.GoTestService.PinNote:input_type -> go_test.v1.PinNoteRequest
+	67,  // 113: go_test.v1.GoTestService.ArchiveNote:input_type -> go_test.v1.ArchiveNoteRequest
+	69,  // 114: go_test.v1.GoTestService.RenderNote:input_type -> go_test.v1.RenderNoteRequest
+	72,  // 115: go_test.v1.GoTestService.GetBacklinks:input_type -> go_test.v1.GetBacklinksRequest
+	74,  // 116: go_test.v1.GoTestService.GetOutgoingLinks:input_type -> go_test.v1.GetOutgoingLinksRequest
+	77,  // 117: go_test.v1.GoTestService.CreateNoteTemplate:input_type -> go_test.v1.CreateNoteTemplateRequest
+	79,  // 118: go_test.v1.GoTestService.GetNoteTemplate:input_type -> go_test.v1.GetNoteTemplateRequest
+	81,  // 119: go_test.v1.GoTestService.UpdateNoteTemplate:input_type -> go_test.v1.UpdateNoteTemplateRequest
+	83,  // 120: go_test.v1.GoTestService.DeleteNoteTemplate:input_type -> go_test.v1.DeleteNoteTemplateRequest
+	85,  // 121: go_test.v1.GoTestService.ListNoteTemplates:input_type -> go_test.v1.ListNoteTemplatesRequest
+	87,  // 122: go_test.v1.GoTestService.CreateNoteFromTemplate:input_type -> go_test.v1.CreateNoteFromTemplateRequest
+	90,  // 123: go_test.v1.GoTestService.UploadAttachment:input_type -> go_test.v1.UploadAttachmentRequest
+	92,  // 124: go_test.v1.GoTestService.DownloadAttachment:input_type -> go_test.v1.DownloadAttachmentRequest
+	94,  // 125: go_test.v1.GoTestService.ListAttachments:input_type -> go_test.v1.ListAttachmentsRequest
+	96,  // 126: go_test.v1.GoTestService.DeleteAttachment:input_type -> go_test.v1.DeleteAttachmentRequest
+	99,  // 127: go_test.v1.GoTestService.AddComment:input_type -> go_test.v1.AddCommentRequest
+	101, // 128: go_test.v1.GoTestService.ListComments:input_type -> go_test.v1.ListCommentsRequest
+	103, // 129: go_test.v1.GoTestService.EditComment:input_type -> go_test.v1.EditCommentRequest
+	105, // 130: go_test.v1.GoTestService.DeleteComment:input_type -> go_test.v1.DeleteCommentRequest
+	108, // 131: go_test.v1.GoTestService.WatchNotes:input_type -> go_test.v1.WatchNotesRequest
+	110, // 132: go_test.v1.GoTestService.CreateWebhook:input_type -> go_test.v1.CreateWebhookRequest
+	112, // 133: go_test.v1.GoTestService.GetWebhook:input_type -> go_test.v1.GetWebhookRequest
+	114, // 134: go_test.v1.GoTestService.UpdateWebhook:input_type -> go_test.v1.UpdateWebhookRequest
+	116, // 135: go_test.v1.GoTestService.DeleteWebhook:input_type -> go_test.v1.DeleteWebhookRequest
+	118, // 136: go_test.v1.GoTestService.ListWebhooks:input_type -> go_test.v1.ListWebhooksRequest
+	121, // 137: go_test.v1.GoTestService.ListWebhookDeliveries:input_type -> go_test.v1.ListWebhookDeliveriesRequest
+	123, // 138: go_test.v1.GoTestService.ExportNotes:input_type -> go_test.v1.ExportNotesRequest
+	127, // 139: go_test.v1.GoTestService.ImportNotes:input_type -> go_test.v1.ImportNotesRequest
+	8,   // 140: go_test.v1.GoTestService.Ping:output_type -> go_test.v1.PingResponse
+	12,  // 141: go_test.v1.GoTestService.CreateNote:output_type -> go_test.v1.CreateNoteResponse
+	17,  // 142: go_test.v1.GoTestService.BulkCreateNotes:output_type -> go_test.v1.BulkCreateNotesResponse
+	19,  // 143: go_test.v1.GoTestService.GetNote:output_type -> go_test.v1.GetNoteResponse
+	22,  // 144: go_test.v1.GoTestService.BatchGetNotes:output_type -> go_test.v1.BatchGetNotesResponse
+	24,  // 145: go_test.v1.GoTestService.UpdateNote:output_type -> go_test.v1.UpdateNoteResponse
+	27,  // 146: go_test.v1.GoTestService.ListNoteRevisions:output_type -> go_test.v1.ListNoteRevisionsResponse
+	29,  // 147: go_test.v1.GoTestService.GetNoteRevision:output_type -> go_test.v1.GetNoteRevisionResponse
+	31,  // 148: go_test.v1.GoTestService.RestoreNoteRevision:output_type -> go_test.v1.RestoreNoteRevisionResponse
+	35,  // 149: go_test.v1.GoTestService.DiffNote:output_type -> go_test.v1.DiffNoteResponse
+	37,  // 150: go_test.v1.GoTestService.DeleteNote:output_type -> go_test.v1.DeleteNoteResponse
+	39,  // 151: go_test.v1.GoTestService.ListTrash:output_type -> go_test.v1.ListTrashResponse
+	41,  // 152: go_test.v1.GoTestService.RestoreNote:output_type -> go_test.v1.RestoreNoteResponse
+	43,  // 153: go_test.v1.GoTestService.PurgeNote:output_type -> go_test.v1.PurgeNoteResponse
+	46,  // 154: go_test.v1.GoTestService.SearchNotes:output_type -> go_test.v1.SearchNotesResponse
+	48,  // 155: go_test.v1.GoTestService.ListNotes:output_type -> go_test.v1.ListNotesResponse
+	51,  // 156: go_test.v1.GoTestService.ListTags:output_type -> go_test.v1.ListTagsResponse
+	54,  // 157: go_test.v1.GoTestService.CreateNotebook:output_type -> go_test.v1.CreateNotebookResponse
+	56,  // 158: go_test.v1.GoTestService.GetNotebook:output_type -> go_test.v1.GetNotebookResponse
+	58,  // 159: go_test.v1.GoTestService.UpdateNotebook:output_type -> go_test.v1.UpdateNotebookResponse
+	60,  // 160: go_test.v1.GoTestService.DeleteNotebook:output_type -> go_test.v1.DeleteNotebookResponse
+	62,  // 161: go_test.v1.GoTestService.ListNotebooks:output_type -> go_test.v1.ListNotebooksResponse
+	64,  // 162: go_test.v1.GoTestService.MoveNote:output_type -> go_test.v1.MoveNoteResponse
+	66,  // 163: go_test.v1.GoTestService.PinNote:output_type -> go_test.v1.PinNoteResponse
+	68,  // 164: go_test.v1.GoTestService.ArchiveNote:output_type -> go_test.v1.ArchiveNoteResponse
+	70,  // 165: go_test.v1.GoTestService.RenderNote:output_type -> go_test.v1.RenderNoteResponse
+	73,  // 166: go_test.v1.GoTestService.GetBacklinks:output_type -> go_test.v1.GetBacklinksResponse
+	75,  // 167: go_test.v1.GoTestService.GetOutgoingLinks:output_type -> go_test.v1.GetOutgoingLinksResponse
+	78,  // 168: go_test.v1.GoTestService.CreateNoteTemplate:output_type -> go_test.v1.CreateNoteTemplateResponse
+	80,  // 169: go_test.v1.GoTestService.GetNoteTemplate:output_type -> go_test.v1.GetNoteTemplateResponse
+	82,  // 170: go_test.v1.GoTestService.UpdateNoteTemplate:output_type -> go_test.v1.UpdateNoteTemplateResponse
+	84,  // 171: go_test.v1.GoTestService.DeleteNoteTemplate:output_type -> go_test.v1.DeleteNoteTemplateResponse
+	86,  // 172: go_test.v1.GoTestService.ListNoteTemplates:output_type -> go_test.v1.ListNoteTemplatesResponse
+	88,  // 173: go_test.v1.GoTestService.CreateNoteFromTemplate:output_type -> go_test.v1.CreateNoteFromTemplateResponse
+	91,  // 174: go_test.v1.GoTestService.UploadAttachment:output_type -> go_test.v1.UploadAttachmentResponse
+	93,  // 175: go_test.v1.GoTestService.DownloadAttachment:output_type -> go_test.v1.DownloadAttachmentResponse
+	95,  // 176: go_test.v1.GoTestService.ListAttachments:output_type -> go_test.v1.ListAttachmentsResponse
+	97,  // 177: go_test.v1.GoTestService.DeleteAttachment:output_type -> go_test.v1.DeleteAttachmentResponse
+	100, // 178: go_test.v1.GoTestService.AddComment:output_type -> go_test.v1.AddCommentResponse
+	102, // 179: go_test.v1.GoTestService.ListComments:output_type -> go_test.v1.ListCommentsResponse
+	104, // 180: go_test.v1.GoTestService.EditComment:output_type -> go_test.v1.EditCommentResponse
+	106, // 181: go_test.v1.GoTestService.DeleteComment:output_type -> go_test.v1.DeleteCommentResponse
+	107, // 182: go_test.v1.GoTestService.WatchNotes:output_type -> go_test.v1.NoteEvent
+	111, // 183: go_test.v1.GoTestService.CreateWebhook:output_type -> go_test.v1.CreateWebhookResponse
+	113, // 184: go_test.v1.GoTestService.GetWebhook:output_type -> go_test.v1.GetWebhookResponse
+	115, // 185: go_test.v1.GoTestService.UpdateWebhook:output_type -> go_test.v1.UpdateWebhookResponse
+	117, // 186: go_test.v1.GoTestService.DeleteWebhook:output_type -> go_test.v1.DeleteWebhookResponse
+	119, // 187: go_test.v1.GoTestService.ListWebhooks:output_type -> go_test.v1.ListWebhooksResponse
+	122, // 188: go_test.v1.GoTestService.ListWebhookDeliveries:output_type -> go_test.v1.ListWebhookDeliveriesResponse
+	126, // 189: go_test.v1.GoTestService.ExportNotes:output_type -> go_test.v1.ExportNotesResponse
+	132, // 190: go_test.v1.GoTestService.ImportNotes:output_type -> go_test.v1.ImportNotesResponse
+	140, // [140:191] is the sub-list for method output_type
+	89,  // [89:140] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_proto_go_test_v1_go_test_proto_init() }
@@ -429,18 +9307,43 @@ func file_proto_go_test_v1_go_test_proto_init() {
 	if File_proto_go_test_v1_go_test_proto != nil {
 		return
 	}
+	file_proto_go_test_v1_validate_proto_init()
+	file_proto_go_test_v1_go_test_proto_msgTypes[6].OneofWrappers = []any{
+		(*BulkCreateNotesRequest_Options)(nil),
+		(*BulkCreateNotesRequest_Note)(nil),
+	}
+	file_proto_go_test_v1_go_test_proto_msgTypes[25].OneofWrappers = []any{}
+	file_proto_go_test_v1_go_test_proto_msgTypes[83].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata_)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_proto_go_test_v1_go_test_proto_msgTypes[86].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Metadata)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_proto_go_test_v1_go_test_proto_msgTypes[119].OneofWrappers = []any{
+		(*ExportNotesResponse_Metadata)(nil),
+		(*ExportNotesResponse_Chunk)(nil),
+		(*ExportNotesResponse_Summary)(nil),
+	}
+	file_proto_go_test_v1_go_test_proto_msgTypes[120].OneofWrappers = []any{
+		(*ImportNotesRequest_Options)(nil),
+		(*ImportNotesRequest_File)(nil),
+		(*ImportNotesRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_go_test_v1_go_test_proto_rawDesc), len(file_proto_go_test_v1_go_test_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      7,
+			NumMessages:   128,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_go_test_v1_go_test_proto_goTypes,
 		DependencyIndexes: file_proto_go_test_v1_go_test_proto_depIdxs,
+		EnumInfos:         file_proto_go_test_v1_go_test_proto_enumTypes,
 		MessageInfos:      file_proto_go_test_v1_go_test_proto_msgTypes,
 	}.Build()
 	File_proto_go_test_v1_go_test_proto = out.File
@@ -19,9 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GoTestService_Ping_FullMethodName       = "/go_test.v1.GoTestService/Ping"
-	GoTestService_CreateNote_FullMethodName = "/go_test.v1.GoTestService/CreateNote"
-	GoTestService_GetNote_FullMethodName    = "/go_test.v1.GoTestService/GetNote"
+	GoTestService_Ping_FullMethodName                   = "/go_test.v1.GoTestService/Ping"
+	GoTestService_CreateNote_FullMethodName             = "/go_test.v1.GoTestService/CreateNote"
+	GoTestService_BulkCreateNotes_FullMethodName        = "/go_test.v1.GoTestService/BulkCreateNotes"
+	GoTestService_GetNote_FullMethodName                = "/go_test.v1.GoTestService/GetNote"
+	GoTestService_BatchGetNotes_FullMethodName          = "/go_test.v1.GoTestService/BatchGetNotes"
+	GoTestService_UpdateNote_FullMethodName             = "/go_test.v1.GoTestService/UpdateNote"
+	GoTestService_ListNoteRevisions_FullMethodName      = "/go_test.v1.GoTestService/ListNoteRevisions"
+	GoTestService_GetNoteRevision_FullMethodName        = "/go_test.v1.GoTestService/GetNoteRevision"
+	GoTestService_RestoreNoteRevision_FullMethodName    = "/go_test.v1.GoTestService/RestoreNoteRevision"
+	GoTestService_DiffNote_FullMethodName               = "/go_test.v1.GoTestService/DiffNote"
+	GoTestService_DeleteNote_FullMethodName             = "/go_test.v1.GoTestService/DeleteNote"
+	GoTestService_ListTrash_FullMethodName              = "/go_test.v1.GoTestService/ListTrash"
+	GoTestService_RestoreNote_FullMethodName            = "/go_test.v1.GoTestService/RestoreNote"
+	GoTestService_PurgeNote_FullMethodName              = "/go_test.v1.GoTestService/PurgeNote"
+	GoTestService_SearchNotes_FullMethodName            = "/go_test.v1.GoTestService/SearchNotes"
+	GoTestService_ListNotes_FullMethodName              = "/go_test.v1.GoTestService/ListNotes"
+	GoTestService_ListTags_FullMethodName               = "/go_test.v1.GoTestService/ListTags"
+	GoTestService_CreateNotebook_FullMethodName         = "/go_test.v1.GoTestService/CreateNotebook"
+	GoTestService_GetNotebook_FullMethodName            = "/go_test.v1.GoTestService/GetNotebook"
+	GoTestService_UpdateNotebook_FullMethodName         = "/go_test.v1.GoTestService/UpdateNotebook"
+	GoTestService_DeleteNotebook_FullMethodName         = "/go_test.v1.GoTestService/DeleteNotebook"
+	GoTestService_ListNotebooks_FullMethodName          = "/go_test.v1.GoTestService/ListNotebooks"
+	GoTestService_MoveNote_FullMethodName               = "/go_test.v1.GoTestService/MoveNote"
+	GoTestService_PinNote_FullMethodName                = "/go_test.v1.GoTestService/PinNote"
+	GoTestService_ArchiveNote_FullMethodName            = "/go_test.v1.GoTestService/ArchiveNote"
+	GoTestService_RenderNote_FullMethodName             = "/go_test.v1.GoTestService/RenderNote"
+	GoTestService_GetBacklinks_FullMethodName           = "/go_test.v1.GoTestService/GetBacklinks"
+	GoTestService_GetOutgoingLinks_FullMethodName       = "/go_test.v1.GoTestService/GetOutgoingLinks"
+	GoTestService_CreateNoteTemplate_FullMethodName     = "/go_test.v1.GoTestService/CreateNoteTemplate"
+	GoTestService_GetNoteTemplate_FullMethodName        = "/go_test.v1.GoTestService/GetNoteTemplate"
+	GoTestService_UpdateNoteTemplate_FullMethodName     = "/go_test.v1.GoTestService/UpdateNoteTemplate"
+	GoTestService_DeleteNoteTemplate_FullMethodName     = "/go_test.v1.GoTestService/DeleteNoteTemplate"
+	GoTestService_ListNoteTemplates_FullMethodName      = "/go_test.v1.GoTestService/ListNoteTemplates"
+	GoTestService_CreateNoteFromTemplate_FullMethodName = "/go_test.v1.GoTestService/CreateNoteFromTemplate"
+	GoTestService_UploadAttachment_FullMethodName       = "/go_test.v1.GoTestService/UploadAttachment"
+	GoTestService_DownloadAttachment_FullMethodName     = "/go_test.v1.GoTestService/DownloadAttachment"
+	GoTestService_ListAttachments_FullMethodName        = "/go_test.v1.GoTestService/ListAttachments"
+	GoTestService_DeleteAttachment_FullMethodName       = "/go_test.v1.GoTestService/DeleteAttachment"
+	GoTestService_AddComment_FullMethodName             = "/go_test.v1.GoTestService/AddComment"
+	GoTestService_ListComments_FullMethodName           = "/go_test.v1.GoTestService/ListComments"
+	GoTestService_EditComment_FullMethodName            = "/go_test.v1.GoTestService/EditComment"
+	GoTestService_DeleteComment_FullMethodName          = "/go_test.v1.GoTestService/DeleteComment"
+	GoTestService_WatchNotes_FullMethodName             = "/go_test.v1.GoTestService/WatchNotes"
+	GoTestService_CreateWebhook_FullMethodName          = "/go_test.v1.GoTestService/CreateWebhook"
+	GoTestService_GetWebhook_FullMethodName             = "/go_test.v1.GoTestService/GetWebhook"
+	GoTestService_UpdateWebhook_FullMethodName          = "/go_test.v1.GoTestService/UpdateWebhook"
+	GoTestService_DeleteWebhook_FullMethodName          = "/go_test.v1.GoTestService/DeleteWebhook"
+	GoTestService_ListWebhooks_FullMethodName           = "/go_test.v1.GoTestService/ListWebhooks"
+	GoTestService_ListWebhookDeliveries_FullMethodName  = "/go_test.v1.GoTestService/ListWebhookDeliveries"
+	GoTestService_ExportNotes_FullMethodName            = "/go_test.v1.GoTestService/ExportNotes"
+	GoTestService_ImportNotes_FullMethodName            = "/go_test.v1.GoTestService/ImportNotes"
 )
 
 // GoTestServiceClient is the client API for GoTestService service.
@@ -32,7 +80,55 @@ const (
 type GoTestServiceClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error)
+	BulkCreateNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BulkCreateNotesRequest, BulkCreateNotesResponse], error)
 	GetNote(ctx context.Context, in *GetNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	BatchGetNotes(ctx context.Context, in *BatchGetNotesRequest, opts ...grpc.CallOption) (*BatchGetNotesResponse, error)
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error)
+	RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error)
+	DiffNote(ctx context.Context, in *DiffNoteRequest, opts ...grpc.CallOption) (*DiffNoteResponse, error)
+	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error)
+	PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error)
+	SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error)
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error)
+	GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error)
+	UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error)
+	DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error)
+	ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error)
+	MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error)
+	PinNote(ctx context.Context, in *PinNoteRequest, opts ...grpc.CallOption) (*PinNoteResponse, error)
+	ArchiveNote(ctx context.Context, in *ArchiveNoteRequest, opts ...grpc.CallOption) (*ArchiveNoteResponse, error)
+	RenderNote(ctx context.Context, in *RenderNoteRequest, opts ...grpc.CallOption) (*RenderNoteResponse, error)
+	GetBacklinks(ctx context.Context, in *GetBacklinksRequest, opts ...grpc.CallOption) (*GetBacklinksResponse, error)
+	GetOutgoingLinks(ctx context.Context, in *GetOutgoingLinksRequest, opts ...grpc.CallOption) (*GetOutgoingLinksResponse, error)
+	CreateNoteTemplate(ctx context.Context, in *CreateNoteTemplateRequest, opts ...grpc.CallOption) (*CreateNoteTemplateResponse, error)
+	GetNoteTemplate(ctx context.Context, in *GetNoteTemplateRequest, opts ...grpc.CallOption) (*GetNoteTemplateResponse, error)
+	UpdateNoteTemplate(ctx context.Context, in *UpdateNoteTemplateRequest, opts ...grpc.CallOption) (*UpdateNoteTemplateResponse, error)
+	DeleteNoteTemplate(ctx context.Context, in *DeleteNoteTemplateRequest, opts ...grpc.CallOption) (*DeleteNoteTemplateResponse, error)
+	ListNoteTemplates(ctx context.Context, in *ListNoteTemplatesRequest, opts ...grpc.CallOption) (*ListNoteTemplatesResponse, error)
+	CreateNoteFromTemplate(ctx context.Context, in *CreateNoteFromTemplateRequest, opts ...grpc.CallOption) (*CreateNoteFromTemplateResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	WatchNotes(ctx context.Context, in *WatchNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NoteEvent], error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesResponse], error)
+	ImportNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse], error)
 }
 
 type goTestServiceClient struct {
@@ -63,6 +159,19 @@ func (c *goTestServiceClient) CreateNote(ctx context.Context, in *CreateNoteRequ
 	return out, nil
 }
 
+func (c *goTestServiceClient) BulkCreateNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BulkCreateNotesRequest, BulkCreateNotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[0], GoTestService_BulkCreateNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BulkCreateNotesRequest, BulkCreateNotesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_BulkCreateNotesClient = grpc.ClientStreamingClient[BulkCreateNotesRequest, BulkCreateNotesResponse]
+
 func (c *goTestServiceClient) GetNote(ctx context.Context, in *GetNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNoteResponse)
@@ -70,112 +179,1617 @@ func (c *goTestServiceClient) GetNote(ctx context.Context, in *GetNoteRequest, o
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *goTestServiceClient) BatchGetNotes(ctx context.Context, in *BatchGetNotesRequest, opts ...grpc.CallOption) (*BatchGetNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetNotesResponse)
+	err := c.cc.Invoke(ctx, GoTestService_BatchGetNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_UpdateNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListNoteRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, GoTestService_RestoreNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DiffNote(ctx context.Context, in *DiffNoteRequest, opts ...grpc.CallOption) (*DiffNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DiffNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_RestoreNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_PurgeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNotesResponse)
+	err := c.cc.Invoke(ctx, GoTestService_SearchNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotesResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNotebookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_CreateNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotebookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotebookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_UpdateNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotebookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotebooksResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListNotebooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_MoveNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) PinNote(ctx context.Context, in *PinNoteRequest, opts ...grpc.CallOption) (*PinNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_PinNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ArchiveNote(ctx context.Context, in *ArchiveNoteRequest, opts ...grpc.CallOption) (*ArchiveNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ArchiveNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) RenderNote(ctx context.Context, in *RenderNoteRequest, opts ...grpc.CallOption) (*RenderNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderNoteResponse)
+	err := c.cc.Invoke(ctx, GoTestService_RenderNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetBacklinks(ctx context.Context, in *GetBacklinksRequest, opts ...grpc.CallOption) (*GetBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBacklinksResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetOutgoingLinks(ctx context.Context, in *GetOutgoingLinksRequest, opts ...grpc.CallOption) (*GetOutgoingLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutgoingLinksResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetOutgoingLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) CreateNoteTemplate(ctx context.Context, in *CreateNoteTemplateRequest, opts ...grpc.CallOption) (*CreateNoteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNoteTemplateResponse)
+	err := c.cc.Invoke(ctx, GoTestService_CreateNoteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetNoteTemplate(ctx context.Context, in *GetNoteTemplateRequest, opts ...grpc.CallOption) (*GetNoteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNoteTemplateResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetNoteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) UpdateNoteTemplate(ctx context.Context, in *UpdateNoteTemplateRequest, opts ...grpc.CallOption) (*UpdateNoteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNoteTemplateResponse)
+	err := c.cc.Invoke(ctx, GoTestService_UpdateNoteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteNoteTemplate(ctx context.Context, in *DeleteNoteTemplateRequest, opts ...grpc.CallOption) (*DeleteNoteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNoteTemplateResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteNoteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListNoteTemplates(ctx context.Context, in *ListNoteTemplatesRequest, opts ...grpc.CallOption) (*ListNoteTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteTemplatesResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListNoteTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) CreateNoteFromTemplate(ctx context.Context, in *CreateNoteFromTemplateRequest, opts ...grpc.CallOption) (*CreateNoteFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNoteFromTemplateResponse)
+	err := c.cc.Invoke(ctx, GoTestService_CreateNoteFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[1], GoTestService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *goTestServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[2], GoTestService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *goTestServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCommentResponse)
+	err := c.cc.Invoke(ctx, GoTestService_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, GoTestService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) WatchNotes(ctx context.Context, in *WatchNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NoteEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[3], GoTestService_WatchNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNotesRequest, NoteEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_WatchNotesClient = grpc.ServerStreamingClient[NoteEvent]
+
+func (c *goTestServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_GetWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, GoTestService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, GoTestService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goTestServiceClient) ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[4], GoTestService_ExportNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportNotesRequest, ExportNotesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_ExportNotesClient = grpc.ServerStreamingClient[ExportNotesResponse]
+
+func (c *goTestServiceClient) ImportNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoTestService_ServiceDesc.Streams[5], GoTestService_ImportNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportNotesRequest, ImportNotesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_ImportNotesClient = grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse]
+
+// GoTestServiceServer is the server API for GoTestService service.
+// All implementations must embed UnimplementedGoTestServiceServer
+// for forward compatibility.
+//
+// Ping service for health check
+type GoTestServiceServer interface {
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
+	BulkCreateNotes(grpc.ClientStreamingServer[BulkCreateNotesRequest, BulkCreateNotesResponse]) error
+	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error)
+	BatchGetNotes(context.Context, *BatchGetNotesRequest) (*BatchGetNotesResponse, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error)
+	RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error)
+	DiffNote(context.Context, *DiffNoteRequest) (*DiffNoteResponse, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error)
+	PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error)
+	SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error)
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error)
+	GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error)
+	UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error)
+	DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error)
+	ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error)
+	MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error)
+	PinNote(context.Context, *PinNoteRequest) (*PinNoteResponse, error)
+	ArchiveNote(context.Context, *ArchiveNoteRequest) (*ArchiveNoteResponse, error)
+	RenderNote(context.Context, *RenderNoteRequest) (*RenderNoteResponse, error)
+	GetBacklinks(context.Context, *GetBacklinksRequest) (*GetBacklinksResponse, error)
+	GetOutgoingLinks(context.Context, *GetOutgoingLinksRequest) (*GetOutgoingLinksResponse, error)
+	CreateNoteTemplate(context.Context, *CreateNoteTemplateRequest) (*CreateNoteTemplateResponse, error)
+	GetNoteTemplate(context.Context, *GetNoteTemplateRequest) (*GetNoteTemplateResponse, error)
+	UpdateNoteTemplate(context.Context, *UpdateNoteTemplateRequest) (*UpdateNoteTemplateResponse, error)
+	DeleteNoteTemplate(context.Context, *DeleteNoteTemplateRequest) (*DeleteNoteTemplateResponse, error)
+	ListNoteTemplates(context.Context, *ListNoteTemplatesRequest) (*ListNoteTemplatesResponse, error)
+	CreateNoteFromTemplate(context.Context, *CreateNoteFromTemplateRequest) (*CreateNoteFromTemplateResponse, error)
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	WatchNotes(*WatchNotesRequest, grpc.ServerStreamingServer[NoteEvent]) error
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesResponse]) error
+	ImportNotes(grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]) error
+	mustEmbedUnimplementedGoTestServiceServer()
+}
+
+// UnimplementedGoTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGoTestServiceServer struct{}
+
+func (UnimplementedGoTestServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedGoTestServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) BulkCreateNotes(grpc.ClientStreamingServer[BulkCreateNotesRequest, BulkCreateNotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BulkCreateNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) BatchGetNotes(context.Context, *BatchGetNotesRequest) (*BatchGetNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteRevision not implemented")
+}
+func (UnimplementedGoTestServiceServer) RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNoteRevision not implemented")
+}
+func (UnimplementedGoTestServiceServer) DiffNote(context.Context, *DiffNoteRequest) (*DiffNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedGoTestServiceServer) RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedGoTestServiceServer) CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotebook not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotebook not implemented")
+}
+func (UnimplementedGoTestServiceServer) UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotebook not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotebook not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotebooks not implemented")
+}
+func (UnimplementedGoTestServiceServer) MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) PinNote(context.Context, *PinNoteRequest) (*PinNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) ArchiveNote(context.Context, *ArchiveNoteRequest) (*ArchiveNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) RenderNote(context.Context, *RenderNoteRequest) (*RenderNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderNote not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetBacklinks(context.Context, *GetBacklinksRequest) (*GetBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBacklinks not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetOutgoingLinks(context.Context, *GetOutgoingLinksRequest) (*GetOutgoingLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutgoingLinks not implemented")
+}
+func (UnimplementedGoTestServiceServer) CreateNoteTemplate(context.Context, *CreateNoteTemplateRequest) (*CreateNoteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteTemplate not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetNoteTemplate(context.Context, *GetNoteTemplateRequest) (*GetNoteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteTemplate not implemented")
+}
+func (UnimplementedGoTestServiceServer) UpdateNoteTemplate(context.Context, *UpdateNoteTemplateRequest) (*UpdateNoteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNoteTemplate not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteNoteTemplate(context.Context, *DeleteNoteTemplateRequest) (*DeleteNoteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNoteTemplate not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListNoteTemplates(context.Context, *ListNoteTemplatesRequest) (*ListNoteTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteTemplates not implemented")
+}
+func (UnimplementedGoTestServiceServer) CreateNoteFromTemplate(context.Context, *CreateNoteFromTemplateRequest) (*CreateNoteFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteFromTemplate not implemented")
+}
+func (UnimplementedGoTestServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedGoTestServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedGoTestServiceServer) AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedGoTestServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedGoTestServiceServer) WatchNotes(*WatchNotesRequest, grpc.ServerStreamingServer[NoteEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedGoTestServiceServer) GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedGoTestServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedGoTestServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedGoTestServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedGoTestServiceServer) ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) ImportNotes(grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportNotes not implemented")
+}
+func (UnimplementedGoTestServiceServer) mustEmbedUnimplementedGoTestServiceServer() {}
+func (UnimplementedGoTestServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGoTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoTestServiceServer will
+// result in compilation errors.
+type UnsafeGoTestServiceServer interface {
+	mustEmbedUnimplementedGoTestServiceServer()
+}
+
+func RegisterGoTestServiceServer(s grpc.ServiceRegistrar, srv GoTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGoTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GoTestService_ServiceDesc, srv)
+}
+
+func _GoTestService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).CreateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_CreateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_BulkCreateNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoTestServiceServer).BulkCreateNotes(&grpc.GenericServerStream[BulkCreateNotesRequest, BulkCreateNotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_BulkCreateNotesServer = grpc.ClientStreamingServer[BulkCreateNotesRequest, BulkCreateNotesResponse]
+
+func _GoTestService_GetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetNote(ctx, req.(*GetNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_BatchGetNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).BatchGetNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_BatchGetNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).BatchGetNotes(ctx, req.(*BatchGetNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_UpdateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_GetNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetNoteRevision(ctx, req.(*GetNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_RestoreNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).RestoreNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_RestoreNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).RestoreNoteRevision(ctx, req.(*RestoreNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_DiffNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DiffNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DiffNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DiffNote(ctx, req.(*DiffNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DeleteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_RestoreNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).RestoreNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_RestoreNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).RestoreNote(ctx, req.(*RestoreNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_PurgeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).PurgeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_PurgeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).PurgeNote(ctx, req.(*PurgeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_SearchNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).SearchNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_SearchNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).SearchNotes(ctx, req.(*SearchNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_CreateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).CreateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_CreateNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).CreateNotebook(ctx, req.(*CreateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_GetNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetNotebook(ctx, req.(*GetNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_UpdateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).UpdateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_UpdateNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).UpdateNotebook(ctx, req.(*UpdateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_DeleteNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DeleteNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DeleteNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DeleteNotebook(ctx, req.(*DeleteNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListNotebooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotebooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListNotebooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListNotebooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListNotebooks(ctx, req.(*ListNotebooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_MoveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).MoveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_MoveNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).MoveNote(ctx, req.(*MoveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_PinNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).PinNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_PinNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).PinNote(ctx, req.(*PinNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ArchiveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ArchiveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ArchiveNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ArchiveNote(ctx, req.(*ArchiveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_RenderNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).RenderNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_RenderNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).RenderNote(ctx, req.(*RenderNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_GetBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetBacklinks(ctx, req.(*GetBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_GetOutgoingLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutgoingLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetOutgoingLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetOutgoingLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetOutgoingLinks(ctx, req.(*GetOutgoingLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_CreateNoteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).CreateNoteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_CreateNoteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).CreateNoteTemplate(ctx, req.(*CreateNoteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_GetNoteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetNoteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetNoteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetNoteTemplate(ctx, req.(*GetNoteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_UpdateNoteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).UpdateNoteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_UpdateNoteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).UpdateNoteTemplate(ctx, req.(*UpdateNoteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_DeleteNoteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DeleteNoteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DeleteNoteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DeleteNoteTemplate(ctx, req.(*DeleteNoteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListNoteTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListNoteTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListNoteTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListNoteTemplates(ctx, req.(*ListNoteTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_CreateNoteFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).CreateNoteFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_CreateNoteFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).CreateNoteFromTemplate(ctx, req.(*CreateNoteFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoTestServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _GoTestService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoTestServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _GoTestService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GoTestServiceServer is the server API for GoTestService service.
-// All implementations must embed UnimplementedGoTestServiceServer
-// for forward compatibility.
-//
-// Ping service for health check
-type GoTestServiceServer interface {
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
-	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error)
-	mustEmbedUnimplementedGoTestServiceServer()
+func _GoTestService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedGoTestServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGoTestServiceServer struct{}
+func _GoTestService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedGoTestServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func _GoTestService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoTestServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNote not implemented")
+
+func _GoTestService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoTestServiceServer) GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
+
+func _GoTestService_WatchNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoTestServiceServer).WatchNotes(m, &grpc.GenericServerStream[WatchNotesRequest, NoteEvent]{ServerStream: stream})
 }
-func (UnimplementedGoTestServiceServer) mustEmbedUnimplementedGoTestServiceServer() {}
-func (UnimplementedGoTestServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeGoTestServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoTestServiceServer will
-// result in compilation errors.
-type UnsafeGoTestServiceServer interface {
-	mustEmbedUnimplementedGoTestServiceServer()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_WatchNotesServer = grpc.ServerStreamingServer[NoteEvent]
+
+func _GoTestService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoTestServiceServer(s grpc.ServiceRegistrar, srv GoTestServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGoTestServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _GoTestService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GoTestService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoTestService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _GoTestService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoTestServiceServer).Ping(ctx, in)
+		return srv.(GoTestServiceServer).UpdateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoTestService_Ping_FullMethodName,
+		FullMethod: GoTestService_UpdateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoTestServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(GoTestServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoTestService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNoteRequest)
+func _GoTestService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoTestServiceServer).CreateNote(ctx, in)
+		return srv.(GoTestServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoTestService_CreateNote_FullMethodName,
+		FullMethod: GoTestService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoTestServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+		return srv.(GoTestServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoTestService_GetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNoteRequest)
+func _GoTestService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoTestServiceServer).GetNote(ctx, in)
+		return srv.(GoTestServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoTestService_GetNote_FullMethodName,
+		FullMethod: GoTestService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoTestServiceServer).GetNote(ctx, req.(*GetNoteRequest))
+		return srv.(GoTestServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoTestService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoTestServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoTestService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoTestServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoTestService_ExportNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoTestServiceServer).ExportNotes(m, &grpc.GenericServerStream[ExportNotesRequest, ExportNotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_ExportNotesServer = grpc.ServerStreamingServer[ExportNotesResponse]
+
+func _GoTestService_ImportNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoTestServiceServer).ImportNotes(&grpc.GenericServerStream[ImportNotesRequest, ImportNotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoTestService_ImportNotesServer = grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]
+
 // GoTestService_ServiceDesc is the grpc.ServiceDesc for GoTestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,7 +1809,206 @@ var GoTestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNote",
 			Handler:    _GoTestService_GetNote_Handler,
 		},
+		{
+			MethodName: "BatchGetNotes",
+			Handler:    _GoTestService_BatchGetNotes_Handler,
+		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _GoTestService_UpdateNote_Handler,
+		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _GoTestService_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "GetNoteRevision",
+			Handler:    _GoTestService_GetNoteRevision_Handler,
+		},
+		{
+			MethodName: "RestoreNoteRevision",
+			Handler:    _GoTestService_RestoreNoteRevision_Handler,
+		},
+		{
+			MethodName: "DiffNote",
+			Handler:    _GoTestService_DiffNote_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _GoTestService_DeleteNote_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _GoTestService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreNote",
+			Handler:    _GoTestService_RestoreNote_Handler,
+		},
+		{
+			MethodName: "PurgeNote",
+			Handler:    _GoTestService_PurgeNote_Handler,
+		},
+		{
+			MethodName: "SearchNotes",
+			Handler:    _GoTestService_SearchNotes_Handler,
+		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _GoTestService_ListNotes_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _GoTestService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateNotebook",
+			Handler:    _GoTestService_CreateNotebook_Handler,
+		},
+		{
+			MethodName: "GetNotebook",
+			Handler:    _GoTestService_GetNotebook_Handler,
+		},
+		{
+			MethodName: "UpdateNotebook",
+			Handler:    _GoTestService_UpdateNotebook_Handler,
+		},
+		{
+			MethodName: "DeleteNotebook",
+			Handler:    _GoTestService_DeleteNotebook_Handler,
+		},
+		{
+			MethodName: "ListNotebooks",
+			Handler:    _GoTestService_ListNotebooks_Handler,
+		},
+		{
+			MethodName: "MoveNote",
+			Handler:    _GoTestService_MoveNote_Handler,
+		},
+		{
+			MethodName: "PinNote",
+			Handler:    _GoTestService_PinNote_Handler,
+		},
+		{
+			MethodName: "ArchiveNote",
+			Handler:    _GoTestService_ArchiveNote_Handler,
+		},
+		{
+			MethodName: "RenderNote",
+			Handler:    _GoTestService_RenderNote_Handler,
+		},
+		{
+			MethodName: "GetBacklinks",
+			Handler:    _GoTestService_GetBacklinks_Handler,
+		},
+		{
+			MethodName: "GetOutgoingLinks",
+			Handler:    _GoTestService_GetOutgoingLinks_Handler,
+		},
+		{
+			MethodName: "CreateNoteTemplate",
+			Handler:    _GoTestService_CreateNoteTemplate_Handler,
+		},
+		{
+			MethodName: "GetNoteTemplate",
+			Handler:    _GoTestService_GetNoteTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateNoteTemplate",
+			Handler:    _GoTestService_UpdateNoteTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteNoteTemplate",
+			Handler:    _GoTestService_DeleteNoteTemplate_Handler,
+		},
+		{
+			MethodName: "ListNoteTemplates",
+			Handler:    _GoTestService_ListNoteTemplates_Handler,
+		},
+		{
+			MethodName: "CreateNoteFromTemplate",
+			Handler:    _GoTestService_CreateNoteFromTemplate_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _GoTestService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _GoTestService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _GoTestService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _GoTestService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _GoTestService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _GoTestService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _GoTestService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _GoTestService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _GoTestService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _GoTestService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _GoTestService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _GoTestService_ListWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkCreateNotes",
+			Handler:       _GoTestService_BulkCreateNotes_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _GoTestService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _GoTestService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNotes",
+			Handler:       _GoTestService_WatchNotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportNotes",
+			Handler:       _GoTestService_ExportNotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportNotes",
+			Handler:       _GoTestService_ImportNotes_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/go_test/v1/go_test.proto",
 }
@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: proto/go_test/v1/validate.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldRules holds the constraints for a single field.
+type FieldRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The field must be set: non-empty for strings, bytes and repeated fields,
+	// non-zero for numbers and enums, present for messages.
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*FieldRules_String_
+	//	*FieldRules_Int32
+	//	*FieldRules_Int64
+	//	*FieldRules_Enum
+	//	*FieldRules_Repeated
+	Type          isFieldRules_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetType() isFieldRules_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FieldRules) GetString_() *StringRules {
+	if x != nil {
+		if x, ok := x.Type.(*FieldRules_String_); ok {
+			return x.String_
+		}
+	}
+	return nil
+}
+
+func (x *FieldRules) GetInt32() *Int32Rules {
+	if x != nil {
+		if x, ok := x.Type.(*FieldRules_Int32); ok {
+			return x.Int32
+		}
+	}
+	return nil
+}
+
+func (x *FieldRules) GetInt64() *Int64Rules {
+	if x != nil {
+		if x, ok := x.Type.(*FieldRules_Int64); ok {
+			return x.Int64
+		}
+	}
+	return nil
+}
+
+func (x *FieldRules) GetEnum() *EnumRules {
+	if x != nil {
+		if x, ok := x.Type.(*FieldRules_Enum); ok {
+			return x.Enum
+		}
+	}
+	return nil
+}
+
+func (x *FieldRules) GetRepeated() *RepeatedRules {
+	if x != nil {
+		if x, ok := x.Type.(*FieldRules_Repeated); ok {
+			return x.Repeated
+		}
+	}
+	return nil
+}
+
+type isFieldRules_Type interface {
+	isFieldRules_Type()
+}
+
+type FieldRules_String_ struct {
+	String_ *StringRules `protobuf:"bytes,2,opt,name=string,proto3,oneof"`
+}
+
+type FieldRules_Int32 struct {
+	Int32 *Int32Rules `protobuf:"bytes,3,opt,name=int32,proto3,oneof"`
+}
+
+type FieldRules_Int64 struct {
+	Int64 *Int64Rules `protobuf:"bytes,4,opt,name=int64,proto3,oneof"`
+}
+
+type FieldRules_Enum struct {
+	Enum *EnumRules `protobuf:"bytes,5,opt,name=enum,proto3,oneof"`
+}
+
+type FieldRules_Repeated struct {
+	Repeated *RepeatedRules `protobuf:"bytes,6,opt,name=repeated,proto3,oneof"`
+}
+
+func (*FieldRules_String_) isFieldRules_Type() {}
+
+func (*FieldRules_Int32) isFieldRules_Type() {}
+
+func (*FieldRules_Int64) isFieldRules_Type() {}
+
+func (*FieldRules_Enum) isFieldRules_Type() {}
+
+func (*FieldRules_Repeated) isFieldRules_Type() {}
+
+// StringRules constrains string fields. Lengths are counted in characters (runes).
+type StringRules struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MinLen   *uint64                `protobuf:"varint,1,opt,name=min_len,json=minLen,proto3,oneof" json:"min_len,omitempty"`
+	MaxLen   *uint64                `protobuf:"varint,2,opt,name=max_len,json=maxLen,proto3,oneof" json:"max_len,omitempty"`
+	MaxBytes *uint64                `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	// RE2 pattern the value must match. Empty values are only checked by min_len/required.
+	Pattern       *string `protobuf:"bytes,4,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringRules) Reset() {
+	*x = StringRules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringRules) ProtoMessage() {}
+
+func (x *StringRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringRules.ProtoReflect.Descriptor instead.
+func (*StringRules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringRules) GetMinLen() uint64 {
+	if x != nil && x.MinLen != nil {
+		return *x.MinLen
+	}
+	return 0
+}
+
+func (x *StringRules) GetMaxLen() uint64 {
+	if x != nil && x.MaxLen != nil {
+		return *x.MaxLen
+	}
+	return 0
+}
+
+func (x *StringRules) GetMaxBytes() uint64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *StringRules) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+type Int32Rules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gt            *int32                 `protobuf:"varint,1,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Gte           *int32                 `protobuf:"varint,2,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lt            *int32                 `protobuf:"varint,3,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Lte           *int32                 `protobuf:"varint,4,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int32Rules) Reset() {
+	*x = Int32Rules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int32Rules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Rules) ProtoMessage() {}
+
+func (x *Int32Rules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Rules.ProtoReflect.Descriptor instead.
+func (*Int32Rules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Int32Rules) GetGt() int32 {
+	if x != nil && x.Gt != nil {
+		return *x.Gt
+	}
+	return 0
+}
+
+func (x *Int32Rules) GetGte() int32 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *Int32Rules) GetLt() int32 {
+	if x != nil && x.Lt != nil {
+		return *x.Lt
+	}
+	return 0
+}
+
+func (x *Int32Rules) GetLte() int32 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+type Int64Rules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gt            *int64                 `protobuf:"varint,1,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Gte           *int64                 `protobuf:"varint,2,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lt            *int64                 `protobuf:"varint,3,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Lte           *int64                 `protobuf:"varint,4,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Rules) Reset() {
+	*x = Int64Rules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Rules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Rules) ProtoMessage() {}
+
+func (x *Int64Rules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Rules.ProtoReflect.Descriptor instead.
+func (*Int64Rules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Int64Rules) GetGt() int64 {
+	if x != nil && x.Gt != nil {
+		return *x.Gt
+	}
+	return 0
+}
+
+func (x *Int64Rules) GetGte() int64 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *Int64Rules) GetLt() int64 {
+	if x != nil && x.Lt != nil {
+		return *x.Lt
+	}
+	return 0
+}
+
+func (x *Int64Rules) GetLte() int64 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+type EnumRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only values declared in the enum are accepted.
+	DefinedOnly   bool `protobuf:"varint,1,opt,name=defined_only,json=definedOnly,proto3" json:"defined_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnumRules) Reset() {
+	*x = EnumRules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumRules) ProtoMessage() {}
+
+func (x *EnumRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumRules.ProtoReflect.Descriptor instead.
+func (*EnumRules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnumRules) GetDefinedOnly() bool {
+	if x != nil {
+		return x.DefinedOnly
+	}
+	return false
+}
+
+type RepeatedRules struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MinItems *uint64                `protobuf:"varint,1,opt,name=min_items,json=minItems,proto3,oneof" json:"min_items,omitempty"`
+	MaxItems *uint64                `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3,oneof" json:"max_items,omitempty"`
+	Unique   bool                   `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Rules applied to every element.
+	Items         *FieldRules `protobuf:"bytes,4,opt,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepeatedRules) Reset() {
+	*x = RepeatedRules{}
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepeatedRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedRules) ProtoMessage() {}
+
+func (x *RepeatedRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_go_test_v1_validate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedRules.ProtoReflect.Descriptor instead.
+func (*RepeatedRules) Descriptor() ([]byte, []int) {
+	return file_proto_go_test_v1_validate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RepeatedRules) GetMinItems() uint64 {
+	if x != nil && x.MinItems != nil {
+		return *x.MinItems
+	}
+	return 0
+}
+
+func (x *RepeatedRules) GetMaxItems() uint64 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return 0
+}
+
+func (x *RepeatedRules) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedRules) GetItems() *FieldRules {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var file_proto_go_test_v1_validate_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         51000,
+		Name:          "go_test.v1.rules",
+		Tag:           "bytes,51000,opt,name=rules",
+		Filename:      "proto/go_test/v1/validate.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional go_test.v1.FieldRules rules = 51000;
+	E_Rules = &file_proto_go_test_v1_validate_proto_extTypes[0]
+)
+
+var File_proto_go_test_v1_validate_proto protoreflect.FileDescriptor
+
+const file_proto_go_test_v1_validate_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/go_test/v1/validate.proto\x12\n" +
+	"go_test.v1\x1a google/protobuf/descriptor.proto\"\xa9\x02\n" +
+	"\n" +
+	"FieldRules\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x121\n" +
+	"\x06string\x18\x02 \x01(\v2\x17.go_test.v1.StringRulesH\x00R\x06string\x12.\n" +
+	"\x05int32\x18\x03 \x01(\v2\x16.go_test.v1.Int32RulesH\x00R\x05int32\x12.\n" +
+	"\x05int64\x18\x04 \x01(\v2\x16.go_test.v1.Int64RulesH\x00R\x05int64\x12+\n" +
+	"\x04enum\x18\x05 \x01(\v2\x15.go_test.v1.EnumRulesH\x00R\x04enum\x127\n" +
+	"\brepeated\x18\x06 \x01(\v2\x19.go_test.v1.RepeatedRulesH\x00R\brepeatedB\x06\n" +
+	"\x04type\"\xbc\x01\n" +
+	"\vStringRules\x12\x1c\n" +
+	"\amin_len\x18\x01 \x01(\x04H\x00R\x06minLen\x88\x01\x01\x12\x1c\n" +
+	"\amax_len\x18\x02 \x01(\x04H\x01R\x06maxLen\x88\x01\x01\x12 \n" +
+	"\tmax_bytes\x18\x03 \x01(\x04H\x02R\bmaxBytes\x88\x01\x01\x12\x1d\n" +
+	"\apattern\x18\x04 \x01(\tH\x03R\apattern\x88\x01\x01B\n" +
+	"\n" +
+	"\b_min_lenB\n" +
+	"\n" +
+	"\b_max_lenB\f\n" +
+	"\n" +
+	"_max_bytesB\n" +
+	"\n" +
+	"\b_pattern\"\x82\x01\n" +
+	"\n" +
+	"Int32Rules\x12\x13\n" +
+	"\x02gt\x18\x01 \x01(\x05H\x00R\x02gt\x88\x01\x01\x12\x15\n" +
+	"\x03gte\x18\x02 \x01(\x05H\x01R\x03gte\x88\x01\x01\x12\x13\n" +
+	"\x02lt\x18\x03 \x01(\x05H\x02R\x02lt\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x04 \x01(\x05H\x03R\x03lte\x88\x01\x01B\x05\n" +
+	"\x03_gtB\x06\n" +
+	"\x04_gteB\x05\n" +
+	"\x03_ltB\x06\n" +
+	"\x04_lte\"\x82\x01\n" +
+	"\n" +
+	"Int64Rules\x12\x13\n" +
+	"\x02gt\x18\x01 \x01(\x03H\x00R\x02gt\x88\x01\x01\x12\x15\n" +
+	"\x03gte\x18\x02 \x01(\x03H\x01R\x03gte\x88\x01\x01\x12\x13\n" +
+	"\x02lt\x18\x03 \x01(\x03H\x02R\x02lt\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x04 \x01(\x03H\x03R\x03lte\x88\x01\x01B\x05\n" +
+	"\x03_gtB\x06\n" +
+	"\x04_gteB\x05\n" +
+	"\x03_ltB\x06\n" +
+	"\x04_lte\".\n" +
+	"\tEnumRules\x12!\n" +
+	"\fdefined_only\x18\x01 \x01(\bR\vdefinedOnly\"\xb5\x01\n" +
+	"\rRepeatedRules\x12 \n" +
+	"\tmin_items\x18\x01 \x01(\x04H\x00R\bminItems\x88\x01\x01\x12 \n" +
+	"\tmax_items\x18\x02 \x01(\x04H\x01R\bmaxItems\x88\x01\x01\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\x12,\n" +
+	"\x05items\x18\x04 \x01(\v2\x16.go_test.v1.FieldRulesR\x05itemsB\f\n" +
+	"\n" +
+	"_min_itemsB\f\n" +
+	"\n" +
+	"_max_items:M\n" +
+	"\x05rules\x12\x1d.google.protobuf.FieldOptions\x18\xb8\x8e\x03 \x01(\v2\x16.go_test.v1.FieldRulesR\x05rulesB\x15Z\x13proto/go_test/v1;v1b\x06proto3"
+
+var (
+	file_proto_go_test_v1_validate_proto_rawDescOnce sync.Once
+	file_proto_go_test_v1_validate_proto_rawDescData []byte
+)
+
+func file_proto_go_test_v1_validate_proto_rawDescGZIP() []byte {
+	file_proto_go_test_v1_validate_proto_rawDescOnce.Do(func() {
+		file_proto_go_test_v1_validate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_go_test_v1_validate_proto_rawDesc), len(file_proto_go_test_v1_validate_proto_rawDesc)))
+	})
+	return file_proto_go_test_v1_validate_proto_rawDescData
+}
+
+var file_proto_go_test_v1_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_go_test_v1_validate_proto_goTypes = []any{
+	(*FieldRules)(nil),                // 0: go_test.v1.FieldRules
+	(*StringRules)(nil),               // 1: go_test.v1.StringRules
+	(*Int32Rules)(nil),                // 2: go_test.v1.Int32Rules
+	(*Int64Rules)(nil),                // 3: go_test.v1.Int64Rules
+	(*EnumRules)(nil),                 // 4: go_test.v1.EnumRules
+	(*RepeatedRules)(nil),             // 5: go_test.v1.RepeatedRules
+	(*descriptorpb.FieldOptions)(nil), // 6: google.protobuf.FieldOptions
+}
+var file_proto_go_test_v1_validate_proto_depIdxs = []int32{
+	1, // 0: go_test.v1.FieldRules.string:type_name -> go_test.v1.StringRules
+	2, // 1: go_test.v1.FieldRules.int32:type_name -> go_test.v1.Int32Rules
+	3, // 2: go_test.v1.FieldRules.int64:type_name -> go_test.v1.Int64Rules
+	4, // 3: go_test.v1.FieldRules.enum:type_name -> go_test.v1.EnumRules
+	5, // 4: go_test.v1.FieldRules.repeated:type_name -> go_test.v1.RepeatedRules
+	0, // 5: go_test.v1.RepeatedRules.items:type_name -> go_test.v1.FieldRules
+	6, // 6: go_test.v1.rules:extendee -> google.protobuf.FieldOptions
+	0, // 7: go_test.v1.rules:type_name -> go_test.v1.FieldRules
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	7, // [7:8] is the sub-list for extension type_name
+	6, // [6:7] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_go_test_v1_validate_proto_init() }
+func file_proto_go_test_v1_validate_proto_init() {
+	if File_proto_go_test_v1_validate_proto != nil {
+		return
+	}
+	file_proto_go_test_v1_validate_proto_msgTypes[0].OneofWrappers = []any{
+		(*FieldRules_String_)(nil),
+		(*FieldRules_Int32)(nil),
+		(*FieldRules_Int64)(nil),
+		(*FieldRules_Enum)(nil),
+		(*FieldRules_Repeated)(nil),
+	}
+	file_proto_go_test_v1_validate_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_go_test_v1_validate_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_go_test_v1_validate_proto_msgTypes[3].OneofWrappers = []any{}
+	file_proto_go_test_v1_validate_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_go_test_v1_validate_proto_rawDesc), len(file_proto_go_test_v1_validate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_go_test_v1_validate_proto_goTypes,
+		DependencyIndexes: file_proto_go_test_v1_validate_proto_depIdxs,
+		MessageInfos:      file_proto_go_test_v1_validate_proto_msgTypes,
+		ExtensionInfos:    file_proto_go_test_v1_validate_proto_extTypes,
+	}.Build()
+	File_proto_go_test_v1_validate_proto = out.File
+	file_proto_go_test_v1_validate_proto_goTypes = nil
+	file_proto_go_test_v1_validate_proto_depIdxs = nil
+}